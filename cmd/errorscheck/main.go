@@ -0,0 +1,19 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Command errorscheck runs the errorscheck go/analysis analyzer standalone,
+// the way any other go vet-style tool is invoked:
+//
+//	go run ./cmd/errorscheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/focela/loom/pkg/errorscheck"
+)
+
+func main() {
+	singlechecker.Main(errorscheck.Analyzer)
+}
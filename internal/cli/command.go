@@ -0,0 +1,177 @@
+/*
+ * FOCELA TECHNOLOGIES INTERNAL USE ONLY LICENSE AGREEMENT
+ *
+ * Copyright (c) 2024 Focela Technologies. All rights reserved.
+ *
+ * Permission is hereby granted to employees or authorized personnel of Focela
+ * Technologies (the "Company") to use this software solely for internal business
+ * purposes within the Company.
+ *
+ * For inquiries or permissions, please contact: legal@focela.com
+ */
+
+// Package cli provides console operations, like options/arguments reading.
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Flag describes a named option a Command accepts.
+type Flag struct {
+	Name    string // Long flag name, e.g. "verbose" for --verbose.
+	Short   string // Optional short flag name, e.g. "v" for -v.
+	Default string // Value used when the flag isn't passed.
+	Usage   string // One-line description shown in help output.
+}
+
+// Context carries the parsed arguments and options for a single Command
+// invocation, resolved against the Flags it declared.
+type Context struct {
+	Args  []string
+	Flags map[string]string
+}
+
+// String returns the resolved value of flag name, or its declared default.
+func (c *Context) String(name string) string {
+	return c.Flags[name]
+}
+
+// Bool returns true if flag name was set to "1", "true", or given without a value.
+func (c *Context) Bool(name string) bool {
+	v := c.Flags[name]
+	return v == "1" || v == "true"
+}
+
+// Command is a single CLI action, optionally dispatching to Subcommands.
+type Command struct {
+	Name        string
+	Usage       string
+	Flags       []Flag
+	Subcommands []*Command
+	Run         func(ctx *Context) error
+}
+
+// findSubcommand returns the Subcommand named name, or nil.
+func (cmd *Command) findSubcommand(name string) *Command {
+	for _, sub := range cmd.Subcommands {
+		if sub.Name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// Execute parses args against cmd (and its Subcommands, dispatched
+// recursively by matching the leading positional argument) and runs the
+// matched command's Run function. It prints generated help and returns nil
+// when -h/--help is requested or no Run is set.
+func (cmd *Command) Execute(args ...string) error {
+	if len(args) == 0 {
+		args = os.Args[1:]
+	}
+
+	current := cmd
+	for len(current.Subcommands) > 0 && len(args) > 0 && !looksLikeFlag(args[0]) {
+		sub := current.findSubcommand(args[0])
+		if sub == nil {
+			break
+		}
+		current = sub
+		args = args[1:]
+	}
+
+	parsedArgs, parsedOpts := ParseUsingDefaultAlgorithm(args...)
+
+	if parsedOpts["h"] != "" || parsedOpts["help"] != "" || containsHelpFlag(args) {
+		fmt.Print(current.Help())
+		return nil
+	}
+
+	ctx := &Context{Args: parsedArgs, Flags: resolveFlags(current.Flags, parsedOpts)}
+
+	if current.Run == nil {
+		fmt.Print(current.Help())
+		return nil
+	}
+	return current.Run(ctx)
+}
+
+// looksLikeFlag reports whether s starts with "-", so Execute doesn't try to
+// match a flag against a subcommand name.
+func looksLikeFlag(s string) bool {
+	return strings.HasPrefix(s, "-")
+}
+
+// containsHelpFlag reports whether args contains a bare -h/--help flag.
+func containsHelpFlag(args []string) bool {
+	for _, a := range args {
+		if a == "-h" || a == "--help" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveFlags merges parsedOpts (keyed by long or short flag name) with each
+// declared Flag's default, so Context.String/Bool always has a value to read.
+func resolveFlags(flags []Flag, parsedOpts map[string]string) map[string]string {
+	resolved := make(map[string]string, len(flags))
+	for _, f := range flags {
+		resolved[f.Name] = f.Default
+		if v, ok := parsedOpts[f.Name]; ok {
+			resolved[f.Name] = v
+		} else if f.Short != "" {
+			if v, ok := parsedOpts[f.Short]; ok {
+				resolved[f.Name] = v
+			}
+		}
+	}
+	return resolved
+}
+
+// Help renders usage text for cmd, listing its flags and subcommands.
+func (cmd *Command) Help() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage: %s", cmd.Name)
+	if len(cmd.Flags) > 0 {
+		b.WriteString(" [flags]")
+	}
+	if len(cmd.Subcommands) > 0 {
+		b.WriteString(" <command>")
+	}
+	b.WriteString("\n")
+
+	if cmd.Usage != "" {
+		fmt.Fprintf(&b, "\n%s\n", cmd.Usage)
+	}
+
+	if len(cmd.Flags) > 0 {
+		b.WriteString("\nFlags:\n")
+		for _, f := range cmd.Flags {
+			name := "--" + f.Name
+			if f.Short != "" {
+				name = "-" + f.Short + ", " + name
+			}
+			fmt.Fprintf(&b, "  %-24s %s\n", name, f.Usage)
+		}
+	}
+
+	if len(cmd.Subcommands) > 0 {
+		b.WriteString("\nCommands:\n")
+		names := make([]string, 0, len(cmd.Subcommands))
+		byName := make(map[string]*Command, len(cmd.Subcommands))
+		for _, sub := range cmd.Subcommands {
+			names = append(names, sub.Name)
+			byName[sub.Name] = sub
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&b, "  %-24s %s\n", name, byName[name].Usage)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// This source code is governed by an MIT License.
+// See LICENSE file for full terms and conditions.
+
+// Package cmd parses command-line arguments and options.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FlagKind identifies the Go type a Flag's value is parsed into.
+type FlagKind int
+
+// Supported Flag value types.
+const (
+	FlagString FlagKind = iota
+	FlagInt
+	FlagBool
+	FlagDuration
+	FlagStringSlice
+)
+
+// Flag describes one named option a Command accepts. Default is used when
+// neither the command line nor EnvVar (if set) supplies a value, following
+// the same precedence as GetOptWithEnv: command line, then environment,
+// then default.
+type Flag struct {
+	Name     string      // Flag name, given on the command line as --Name or -Name.
+	Short    string      // Optional single-character alias, given as -x; also eligible for POSIX bundling (-xyz).
+	Usage    string      // One-line description shown in generated help.
+	Kind     FlagKind    // How the raw string value is parsed.
+	Default  interface{} // Value used if the flag is absent everywhere.
+	EnvVar   string      // Environment variable consulted if the flag is absent on the command line.
+	Required bool        // If true, Run fails unless the flag is set somewhere.
+}
+
+// ArgSpec bounds and names a Command's positional arguments. Min and Max are
+// inclusive; Max of 0 means unbounded. Names is used only for help text and
+// may be shorter than Max (trailing positional arguments are unnamed).
+type ArgSpec struct {
+	Min   int
+	Max   int
+	Names []string
+}
+
+// Command is a named, optionally nested CLI command. A tree of Commands is
+// run with Run (or Execute, which also reports errors to stderr), which
+// walks argv to find the deepest matching Subcommand and invokes its Action.
+type Command struct {
+	Name        string
+	Usage       string
+	Flags       []Flag
+	Args        *ArgSpec
+	Subcommands []*Command
+	Action      func(ctx *Context) error
+
+	// MutuallyExclusive lists groups of flag names where at most one member
+	// of each group may be set. RequireOneOf lists groups where at least one
+	// member must be set. Both are validated after Flags' own Required/
+	// Default/EnvVar resolution.
+	MutuallyExclusive [][]string
+	RequireOneOf      [][]string
+}
+
+// Context carries the resolved Command, its positional arguments, and its
+// flag values for the duration of one Action call.
+type Context struct {
+	Command *Command
+	Args    []string
+	opts    map[string]string
+}
+
+// Arg returns the positional argument at index, or def[0] if out of range.
+func (ctx *Context) Arg(index int, def ...string) string {
+	if index < len(ctx.Args) {
+		return ctx.Args[index]
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return ""
+}
+
+// String returns the resolved value of the named flag.
+func (ctx *Context) String(name string) string {
+	if v, ok := ctx.opts[name]; ok {
+		return v
+	}
+	return ""
+}
+
+// Int returns the resolved value of the named flag, or 0 if it does not parse as an integer.
+func (ctx *Context) Int(name string) int {
+	n, _ := strconv.Atoi(ctx.opts[name])
+	return n
+}
+
+// Bool returns the resolved value of the named flag. A flag given on the
+// command line with no value (e.g. `--verbose`) resolves to true.
+func (ctx *Context) Bool(name string) bool {
+	v, ok := ctx.opts[name]
+	if !ok {
+		return false
+	}
+	if v == "" {
+		return true
+	}
+	b, _ := strconv.ParseBool(v)
+	return b
+}
+
+// Duration returns the resolved value of the named flag, or 0 if it does not parse as a duration.
+func (ctx *Context) Duration(name string) time.Duration {
+	d, _ := time.ParseDuration(ctx.opts[name])
+	return d
+}
+
+// StringSlice returns the resolved value of the named flag split on commas,
+// or nil if the flag is unset or empty.
+func (ctx *Context) StringSlice(name string) []string {
+	v, ok := ctx.opts[name]
+	if !ok || v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// Run parses args against cmd's subcommand tree and invokes the Action of
+// the deepest matching Subcommand. A leading run of non-flag tokens is
+// walked against Subcommands by Name; the first token that does not match
+// a Subcommand name, or the first flag token, ends the walk. `--` ends
+// option parsing: every token after it is treated as a positional argument
+// even if it looks like a flag.
+//
+// It also populates the package-level parsedArgs/parsedOptions from the
+// resolved leaf command, so the existing GetOpt/GetArg/GetOptWithEnv
+// functions keep returning sensible values for callers that have not been
+// updated to use a Context.
+func (cmd *Command) Run(args []string) error {
+	leaf, rest := cmd.dispatch(args)
+	rest = leaf.expandBundledShortFlags(rest)
+
+	positional, opts, err := parseCommandArgs(rest)
+	if err != nil {
+		return err
+	}
+	leaf.normalizeShortNames(opts)
+
+	if _, ok := opts["help"]; ok {
+		fmt.Print(leaf.helpText())
+		return nil
+	}
+	if _, ok := opts["h"]; ok {
+		fmt.Print(leaf.helpText())
+		return nil
+	}
+	if shell, ok := opts["completion"]; ok {
+		script, err := leaf.Completion(shell)
+		if err != nil {
+			return err
+		}
+		fmt.Print(script)
+		return nil
+	}
+
+	resolved, err := leaf.resolveFlags(opts)
+	if err != nil {
+		return err
+	}
+	if err := leaf.validateGroups(resolved); err != nil {
+		return err
+	}
+	if err := leaf.validateArgs(positional); err != nil {
+		return err
+	}
+
+	parsedArgs = positional
+	parsedOptions = opts
+
+	if leaf.Action == nil {
+		fmt.Print(leaf.helpText())
+		return nil
+	}
+	return leaf.Action(&Context{Command: leaf, Args: positional, opts: resolved})
+}
+
+// Execute is the typical entry point for a CLI's main: it runs cmd against
+// args and, on error, prints the error and cmd's usage to stderr before
+// returning the error so main can set a non-zero exit status.
+func (cmd *Command) Execute(args []string) error {
+	if err := cmd.Run(args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n\n", err)
+		fmt.Fprint(os.Stderr, cmd.helpText())
+		return err
+	}
+	return nil
+}
+
+// dispatch walks args against cmd's Subcommands by Name, stopping at the
+// first flag token or the first token that does not name a Subcommand. It
+// returns the deepest Command reached and the remaining, unconsumed args.
+func (cmd *Command) dispatch(args []string) (*Command, []string) {
+	leaf := cmd
+	for len(args) > 0 {
+		token := args[0]
+		if token == "--" || strings.HasPrefix(token, "-") {
+			break
+		}
+		next := leaf.findSubcommand(token)
+		if next == nil {
+			break
+		}
+		leaf = next
+		args = args[1:]
+	}
+	return leaf, args
+}
+
+func (cmd *Command) findSubcommand(name string) *Command {
+	for _, sub := range cmd.Subcommands {
+		if sub.Name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// resolveFlags merges cmd's Flags against the options parsed from the
+// command line, falling back to each Flag's EnvVar and then its Default,
+// and reports an error if a Required flag is set nowhere.
+func (cmd *Command) resolveFlags(opts map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(opts)+len(cmd.Flags))
+	for k, v := range opts {
+		resolved[k] = v
+	}
+	for _, flag := range cmd.Flags {
+		if _, ok := resolved[flag.Name]; ok {
+			continue
+		}
+		if flag.EnvVar != "" {
+			if v, ok := os.LookupEnv(flag.EnvVar); ok {
+				resolved[flag.Name] = v
+				continue
+			}
+		}
+		if flag.Default != nil {
+			resolved[flag.Name] = fmt.Sprint(flag.Default)
+			continue
+		}
+		if flag.Required {
+			return nil, fmt.Errorf("cmd: missing required flag --%s for command %q", flag.Name, cmd.Name)
+		}
+	}
+	return resolved, nil
+}
+
+// helpText renders auto-generated --help output for cmd.
+func (cmd *Command) helpText() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage: %s", cmd.Name)
+	if len(cmd.Subcommands) > 0 {
+		b.WriteString(" [command]")
+	}
+	if len(cmd.Flags) > 0 {
+		b.WriteString(" [flags]")
+	}
+	for _, name := range cmd.Args.names() {
+		fmt.Fprintf(&b, " %s", name)
+	}
+	b.WriteString("\n")
+	if cmd.Usage != "" {
+		fmt.Fprintf(&b, "\n%s\n", cmd.Usage)
+	}
+	if len(cmd.Subcommands) > 0 {
+		b.WriteString("\nCommands:\n")
+		for _, sub := range cmd.Subcommands {
+			fmt.Fprintf(&b, "  %-16s %s\n", sub.Name, sub.Usage)
+		}
+	}
+	if len(cmd.Flags) > 0 {
+		b.WriteString("\nFlags:\n")
+		for _, flag := range cmd.Flags {
+			label := "--" + flag.Name
+			if flag.Short != "" {
+				label = "-" + flag.Short + ", " + label
+			}
+			fmt.Fprintf(&b, "  %-20s %s\n", label, flag.Usage)
+		}
+	}
+	return b.String()
+}
+
+// parseCommandArgs splits args into positional arguments and options,
+// reusing the same option syntax as parseDefault, except that everything
+// following a `--` token is always treated as positional.
+func parseCommandArgs(args []string) (positional []string, opts map[string]string, err error) {
+	opts = make(map[string]string)
+	positional = make([]string, 0)
+
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		matches := optionRegex.FindStringSubmatch(args[i])
+		if len(matches) <= 2 {
+			positional = append(positional, args[i])
+			continue
+		}
+		if matches[2] == "=" {
+			opts[matches[1]] = matches[3]
+			continue
+		}
+		if i < len(args)-1 && !(len(args[i+1]) > 0 && args[i+1][0] == '-') {
+			opts[matches[1]] = args[i+1]
+			i++
+			continue
+		}
+		opts[matches[1]] = matches[3]
+	}
+	return positional, opts, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// This source code is governed by an MIT License.
+// See LICENSE file for full terms and conditions.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Completion renders a shell completion script for cmd's Subcommand and
+// Flag tree in the given shell ("bash", "zsh", or "fish"), or an error if
+// shell is not one of those.
+func (cmd *Command) Completion(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return cmd.bashCompletion(), nil
+	case "zsh":
+		return cmd.zshCompletion(), nil
+	case "fish":
+		return cmd.fishCompletion(), nil
+	default:
+		return "", fmt.Errorf("cmd: unsupported completion shell %q", shell)
+	}
+}
+
+// flagCompletionWords returns every --name and -short token cmd accepts,
+// for shells whose completion format is just a flat word list.
+func (cmd *Command) flagCompletionWords() []string {
+	words := make([]string, 0, len(cmd.Flags)*2)
+	for _, flag := range cmd.Flags {
+		words = append(words, "--"+flag.Name)
+		if flag.Short != "" {
+			words = append(words, "-"+flag.Short)
+		}
+	}
+	return words
+}
+
+func (cmd *Command) subcommandNames() []string {
+	names := make([]string, 0, len(cmd.Subcommands))
+	for _, sub := range cmd.Subcommands {
+		names = append(names, sub.Name)
+	}
+	return names
+}
+
+// bashCompletion renders a complete()-based completion function following
+// the standard bash-completion convention of reading COMP_WORDS/COMP_CWORD
+// and writing candidates into COMPREPLY.
+func (cmd *Command) bashCompletion() string {
+	var b strings.Builder
+	fname := "_" + completionFuncName(cmd.Name)
+	fmt.Fprintf(&b, "# bash completion for %s\n", cmd.Name)
+	fmt.Fprintf(&b, "%s() {\n", fname)
+	b.WriteString("  local cur words\n")
+	b.WriteString("  cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	fmt.Fprintf(&b, "  words=\"%s\"\n", strings.Join(append(cmd.subcommandNames(), cmd.flagCompletionWords()...), " "))
+	b.WriteString("  COMPREPLY=( $(compgen -W \"${words}\" -- \"${cur}\") )\n")
+	b.WriteString("}\n")
+	fmt.Fprintf(&b, "complete -F %s %s\n", fname, cmd.Name)
+	return b.String()
+}
+
+// zshCompletion renders a compdef-based completion function using zsh's
+// _arguments/_describe builtins for subcommands and flags.
+func (cmd *Command) zshCompletion() string {
+	var b strings.Builder
+	fname := "_" + completionFuncName(cmd.Name)
+	fmt.Fprintf(&b, "#compdef %s\n", cmd.Name)
+	fmt.Fprintf(&b, "%s() {\n", fname)
+	b.WriteString("  local -a subcommands flags\n")
+	fmt.Fprintf(&b, "  subcommands=(%s)\n", strings.Join(quoteAll(cmd.subcommandNames()), " "))
+	fmt.Fprintf(&b, "  flags=(%s)\n", strings.Join(quoteAll(cmd.flagCompletionWords()), " "))
+	b.WriteString("  _describe 'command' subcommands\n")
+	b.WriteString("  _describe 'flag' flags\n")
+	b.WriteString("}\n")
+	fmt.Fprintf(&b, "%s \"$@\"\n", fname)
+	return b.String()
+}
+
+// fishCompletion renders a series of `complete -c` directives, fish's
+// native completion format.
+func (cmd *Command) fishCompletion() string {
+	var b strings.Builder
+	for _, sub := range cmd.Subcommands {
+		fmt.Fprintf(&b, "complete -c %s -f -n __fish_use_subcommand -a %s", cmd.Name, sub.Name)
+		if sub.Usage != "" {
+			fmt.Fprintf(&b, " -d %q", sub.Usage)
+		}
+		b.WriteString("\n")
+	}
+	for _, flag := range cmd.Flags {
+		fmt.Fprintf(&b, "complete -c %s -l %s", cmd.Name, flag.Name)
+		if flag.Short != "" {
+			fmt.Fprintf(&b, " -s %s", flag.Short)
+		}
+		if flag.Usage != "" {
+			fmt.Fprintf(&b, " -d %q", flag.Usage)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// completionFuncName turns a command name into a safe shell identifier
+// fragment by replacing every run of non-alphanumeric characters with "_".
+func completionFuncName(name string) string {
+	var b strings.Builder
+	prevUnderscore := false
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			prevUnderscore = false
+			continue
+		}
+		if !prevUnderscore {
+			b.WriteByte('_')
+			prevUnderscore = true
+		}
+	}
+	return b.String()
+}
+
+func quoteAll(words []string) []string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = fmt.Sprintf("%q", w)
+	}
+	return quoted
+}
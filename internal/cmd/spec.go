@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// This source code is governed by an MIT License.
+// See LICENSE file for full terms and conditions.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// names returns spec.Names, or nil if spec is nil, for use in help text
+// without every caller needing a nil check.
+func (spec *ArgSpec) names() []string {
+	if spec == nil {
+		return nil
+	}
+	return spec.Names
+}
+
+// validateArgs checks positional against cmd.Args' Min/Max bounds. A nil
+// Args imposes no bound, matching the pre-existing behavior of accepting
+// any number of positional arguments.
+func (cmd *Command) validateArgs(positional []string) error {
+	if cmd.Args == nil {
+		return nil
+	}
+	n := len(positional)
+	if n < cmd.Args.Min {
+		return fmt.Errorf("cmd: %q requires at least %d argument(s), got %d", cmd.Name, cmd.Args.Min, n)
+	}
+	if cmd.Args.Max > 0 && n > cmd.Args.Max {
+		return fmt.Errorf("cmd: %q accepts at most %d argument(s), got %d", cmd.Name, cmd.Args.Max, n)
+	}
+	return nil
+}
+
+// findFlagByShort returns the Flag registered under the given single-
+// character Short name, or nil if cmd has none.
+func (cmd *Command) findFlagByShort(short string) *Flag {
+	for i := range cmd.Flags {
+		if cmd.Flags[i].Short == short {
+			return &cmd.Flags[i]
+		}
+	}
+	return nil
+}
+
+// normalizeShortNames rewrites every key in opts that names a Flag's Short
+// alias to that Flag's long Name, in place, so resolveFlags, validateGroups,
+// and Context accessors only ever need to know about long names.
+func (cmd *Command) normalizeShortNames(opts map[string]string) {
+	for key, value := range opts {
+		flag := cmd.findFlagByShort(key)
+		if flag == nil || flag.Name == key {
+			continue
+		}
+		if _, exists := opts[flag.Name]; !exists {
+			opts[flag.Name] = value
+		}
+		delete(opts, key)
+	}
+}
+
+// expandBundledShortFlags rewrites a single `-abc` token into `-a -b -c`
+// when every one of a, b, and c names a registered boolean Short flag on
+// cmd, per POSIX bundling conventions. Tokens that don't fully resolve to
+// known boolean short flags (including a bare `-` or long `--flag`) are left
+// untouched, so parseCommandArgs's existing handling of `--flag=value` style
+// options and non-flag arguments is unaffected.
+func (cmd *Command) expandBundledShortFlags(args []string) []string {
+	out := make([]string, 0, len(args))
+	for _, token := range args {
+		if !isBundleCandidate(token) {
+			out = append(out, token)
+			continue
+		}
+		chars := token[1:]
+		expanded := make([]string, 0, len(chars))
+		ok := true
+		for _, r := range chars {
+			flag := cmd.findFlagByShort(string(r))
+			if flag == nil || flag.Kind != FlagBool {
+				ok = false
+				break
+			}
+			expanded = append(expanded, "-"+string(r))
+		}
+		if !ok {
+			out = append(out, token)
+			continue
+		}
+		out = append(out, expanded...)
+	}
+	return out
+}
+
+// isBundleCandidate reports whether token looks like a bundle of short
+// flags: a single dash followed by two or more non-dash characters, and not
+// `--`, and containing no `=` (an `=` means it's a single flag with a
+// value, e.g. `-o=value`, not a bundle).
+func isBundleCandidate(token string) bool {
+	if len(token) < 3 || token[0] != '-' || token[1] == '-' {
+		return false
+	}
+	return !strings.Contains(token, "=")
+}
+
+// validateGroups checks cmd's MutuallyExclusive and RequireOneOf flag
+// groups against resolved, which must already reflect Required/EnvVar/
+// Default resolution (so a Default-filled flag counts as "set").
+func (cmd *Command) validateGroups(resolved map[string]string) error {
+	for _, group := range cmd.MutuallyExclusive {
+		var set []string
+		for _, name := range group {
+			if _, ok := resolved[name]; ok {
+				set = append(set, name)
+			}
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("cmd: flags %s are mutually exclusive for command %q", strings.Join(set, ", "), cmd.Name)
+		}
+	}
+	for _, group := range cmd.RequireOneOf {
+		for _, name := range group {
+			if _, ok := resolved[name]; ok {
+				goto satisfied
+			}
+		}
+		return fmt.Errorf("cmd: one of %s must be set for command %q", strings.Join(group, ", "), cmd.Name)
+	satisfied:
+	}
+	return nil
+}
@@ -2,143 +2,185 @@
 // This software is provided "as is", without any warranty.
 // Licensed under the MIT License – see LICENSE file for details.
 
-// Package command provides utilities for parsing command-line arguments and options.
+// Package command wires application CLI commands to github.com/spf13/cobra,
+// with configuration resolved through github.com/spf13/viper so every value
+// follows the same precedence: CLI flag > environment variable > config file
+// (YAML/TOML/JSON, auto-detected by extension) > default.
 package command
 
 import (
+	"context"
 	"os"
-	"regexp"
+	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
-// Matches options in formats: -flag, --flag, -flag=value, --flag=value
+// EnvPrefix, when non-empty, is prepended (with an underscore) ahead of every
+// dotted config key when resolving it from the environment. It defaults to
+// empty because this repo's keys already carry their own namespace as their
+// first dotted segment (e.g. "loom.error.stack.mode" resolves environment
+// variable LOOM_ERROR_STACK_MODE without it).
+var EnvPrefix = ""
+
 var (
-	defaultParsedArgs    []string
-	defaultParsedOptions map[string]string
-	argumentOptionRegex  = regexp.MustCompile(`^\-{1,2}([\w\?\.\-]+)(=){0,1}(.*)$`)
+	root = &cobra.Command{
+		Use:           appArg0(),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	v = viper.New()
+
+	// positionalArgs holds the leftover non-flag arguments from the most
+	// recent Execute call, for the GetArg/GetArgAll shims.
+	positionalArgs []string
 )
 
-// Init initializes the package with provided arguments or os.Args if none given.
-func Init(args ...string) {
-	if len(args) == 0 {
-		if len(defaultParsedArgs) > 0 || len(defaultParsedOptions) > 0 {
-			return
-		}
-		args = os.Args
-	} else {
-		defaultParsedArgs = nil
-		defaultParsedOptions = make(map[string]string)
-	}
+func init() {
+	configureConfigFile(root.Use)
+
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+}
+
+// appArg0 returns the program name used as the root command's Use and as the
+// application name under which a config file is searched for.
+func appArg0() string {
+	return filepath.Base(os.Args[0])
+}
 
-	defaultParsedArgs, defaultParsedOptions = ParseUsingDefaultAlgorithm(args...)
-}
-
-// ParseUsingDefaultAlgorithm separates command arguments into args and options.
-// Handles formats: -name=value, --name=value, -name value, --name value, or -flag, --flag
-func ParseUsingDefaultAlgorithm(args ...string) ([]string, map[string]string) {
-	parsedArgs := make([]string, 0, len(args))
-	parsedOptions := make(map[string]string)
-
-	for i := 0; i < len(args); {
-		matches := argumentOptionRegex.FindStringSubmatch(args[i])
-
-		if len(matches) > 2 {
-			optName := matches[1]
-			hasEquals := matches[2] == "="
-			optValue := matches[3]
-
-			if hasEquals {
-				// -name=value format
-				parsedOptions[optName] = optValue
-			} else if i < len(args)-1 && (len(args[i+1]) == 0 || args[i+1][0] != '-') {
-				// -name value format
-				parsedOptions[optName] = args[i+1]
-				i += 2
-				continue
-			} else {
-				// -flag (without value)
-				parsedOptions[optName] = optValue
-			}
-		} else {
-			// Regular argument
-			parsedArgs = append(parsedArgs, args[i])
+// configureConfigFile points v at the standard search locations for a
+// "config" file named after appName: ./, $XDG_CONFIG_HOME/<app>/, and
+// /etc/<app>/. Viper auto-detects YAML/TOML/JSON from whichever file it
+// finds first; it is not an error if none exists.
+func configureConfigFile(appName string) {
+	v.SetConfigName("config")
+	v.AddConfigPath(".")
+
+	xdgConfigHome := os.Getenv("XDG_CONFIG_HOME")
+	if xdgConfigHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			xdgConfigHome = filepath.Join(home, ".config")
 		}
-		i++
 	}
+	if xdgConfigHome != "" {
+		v.AddConfigPath(filepath.Join(xdgConfigHome, appName))
+	}
+	v.AddConfigPath(filepath.Join("/etc", appName))
 
-	return parsedArgs, parsedOptions
+	_ = v.ReadInConfig()
 }
 
-// GetOpt returns the value of option 'name' or default/empty if not found.
-func GetOpt(name string, def ...string) string {
-	Init()
+// RegisterCommand adds cmd as a top-level subcommand of the application's
+// root command, binding every flag it (and its own subcommands) declare into
+// the Viper store so GetString/GetInt/... and Unmarshal see them alongside
+// environment and config file values.
+func RegisterCommand(cmd *cobra.Command) {
+	bindFlagSet(cmd)
+	root.AddCommand(cmd)
+}
 
-	if v, ok := defaultParsedOptions[name]; ok {
-		return v
+// bindFlagSet recursively binds cmd's local and persistent flags into v.
+func bindFlagSet(cmd *cobra.Command) {
+	_ = v.BindPFlags(cmd.Flags())
+	_ = v.BindPFlags(cmd.PersistentFlags())
+	for _, sub := range cmd.Commands() {
+		bindFlagSet(sub)
 	}
+}
 
-	if len(def) > 0 {
-		return def[0]
+// Execute runs the registered command tree against args (os.Args[1:] if args
+// is empty), bound to ctx for cancellation, and records any leftover
+// positional arguments for GetArg/GetArgAll.
+func Execute(ctx context.Context, args ...string) error {
+	if len(args) > 0 {
+		root.SetArgs(args)
 	}
+	err := root.ExecuteContext(ctx)
+	positionalArgs = root.Flags().Args()
+	return err
+}
 
-	return ""
+// GetString returns key's resolved value as a string.
+func GetString(key string) string {
+	return v.GetString(key)
 }
 
-// GetOptAll returns all parsed options.
-func GetOptAll() map[string]string {
-	Init()
-	return defaultParsedOptions
+// GetInt returns key's resolved value as an int.
+func GetInt(key string) int {
+	return v.GetInt(key)
 }
 
-// ContainsOpt checks if option 'name' exists.
-func ContainsOpt(name string) bool {
-	Init()
-	_, ok := defaultParsedOptions[name]
-	return ok
+// GetBool returns key's resolved value as a bool.
+func GetBool(key string) bool {
+	return v.GetBool(key)
 }
 
-// GetArg returns the argument at 'index' or default/empty if not found.
-func GetArg(index int, def ...string) string {
-	Init()
+// GetDuration returns key's resolved value as a time.Duration.
+func GetDuration(key string) time.Duration {
+	return v.GetDuration(key)
+}
 
-	if index < len(defaultParsedArgs) {
-		return defaultParsedArgs[index]
-	}
+// Unmarshal decodes the entire resolved configuration into target, which
+// must be a pointer to a struct (see viper.Unmarshal for tag conventions).
+func Unmarshal(target interface{}) error {
+	return v.Unmarshal(target)
+}
 
+// GetOpt returns the value of key (a dotted path, e.g. "error.stack.mode", or
+// a bare flag name, e.g. "non-interactive") from the Viper store, or
+// def[0]/"" if unset. It is a thin backwards-compatible shim for callers
+// migrated from the old ad-hoc parser; new code should prefer GetString.
+func GetOpt(key string, def ...string) string {
+	if v.IsSet(key) {
+		return v.GetString(key)
+	}
 	if len(def) > 0 {
 		return def[0]
 	}
-
 	return ""
 }
 
-// GetArgAll returns all parsed arguments.
-func GetArgAll() []string {
-	Init()
-	return defaultParsedArgs
-}
-
-// GetOptWithEnv returns option value or environment variable or default.
-// Options: lowercase with dots (app.setting.name)
-// Env vars: uppercase with underscores (APP_SETTING_NAME)
+// GetOptWithEnv is an alias of GetOpt kept for existing call sites: Viper
+// already resolves key through the CLI flag > env var > config file >
+// default chain, so no separate environment-specific lookup is needed.
 func GetOptWithEnv(key string, def ...string) string {
-	// Command line format (lowercase with dots)
-	cmdKey := strings.ToLower(strings.ReplaceAll(key, "_", "."))
+	return GetOpt(key, def...)
+}
 
-	if ContainsOpt(cmdKey) {
-		return GetOpt(cmdKey)
+// GetOptAll returns every resolved key (flags, env vars, and config file
+// entries alike) as a flat map of dotted keys to string values.
+func GetOptAll() map[string]string {
+	keys := v.AllKeys()
+	all := make(map[string]string, len(keys))
+	for _, key := range keys {
+		all[key] = v.GetString(key)
 	}
+	return all
+}
 
-	// Environment variable format (uppercase with underscores)
-	envKey := strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+// ContainsOpt reports whether key has a resolved value from any source.
+func ContainsOpt(key string) bool {
+	return v.IsSet(key)
+}
 
-	if value, exists := os.LookupEnv(envKey); exists {
-		return value
+// GetArg returns the positional argument at index from the most recent
+// Execute call, or default/empty if not found.
+func GetArg(index int, def ...string) string {
+	if index < len(positionalArgs) {
+		return positionalArgs[index]
 	}
-
 	if len(def) > 0 {
 		return def[0]
 	}
-
 	return ""
 }
+
+// GetArgAll returns every positional argument from the most recent Execute call.
+func GetArgAll() []string {
+	return positionalArgs
+}
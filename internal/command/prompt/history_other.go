@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+//go:build !windows
+
+package prompt
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// history holds previously entered lines for arrow-key recall within a
+// single process lifetime, most recent last.
+var history []string
+
+const (
+	keyBackspace = 127
+	keyCtrlC     = 3
+	keyEnter     = '\r'
+	keyNewline   = '\n'
+	keyEscape    = 27
+)
+
+// readLineWithHistory reads one line from stdin in raw mode, supporting
+// backspace and up/down arrow recall of previously entered lines. It returns
+// ok=false if raw mode can't be entered, so callers fall back to bufio.
+func readLineWithHistory() (line string, ok bool) {
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", false
+	}
+	defer term.Restore(fd, oldState)
+
+	buf := []rune{}
+	historyIndex := len(history)
+	reader := make([]byte, 1)
+
+	redraw := func() {
+		os.Stderr.Write([]byte("\r\x1b[K"))
+		os.Stderr.WriteString(string(buf))
+	}
+
+	for {
+		n, err := os.Stdin.Read(reader)
+		if err != nil || n == 0 {
+			return string(buf), true
+		}
+
+		switch reader[0] {
+		case keyEnter, keyNewline:
+			os.Stderr.Write([]byte("\r\n"))
+			result := string(buf)
+			if result != "" {
+				history = append(history, result)
+			}
+			return result, true
+		case keyCtrlC:
+			return "", true
+		case keyBackspace, '\b':
+			if len(buf) > 0 {
+				buf = buf[:len(buf)-1]
+				redraw()
+			}
+		case keyEscape:
+			// Possible arrow key sequence: ESC [ A/B/C/D.
+			seq := make([]byte, 2)
+			if n, _ := os.Stdin.Read(seq); n < 2 || seq[0] != '[' {
+				continue
+			}
+			switch seq[1] {
+			case 'A': // Up
+				if historyIndex > 0 {
+					historyIndex--
+					buf = []rune(history[historyIndex])
+					redraw()
+				}
+			case 'B': // Down
+				if historyIndex < len(history)-1 {
+					historyIndex++
+					buf = []rune(history[historyIndex])
+				} else {
+					historyIndex = len(history)
+					buf = nil
+				}
+				redraw()
+			}
+		default:
+			buf = append(buf, rune(reader[0]))
+			os.Stderr.Write(reader)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+//go:build windows
+
+package prompt
+
+// readLineWithHistory is not implemented for Windows consoles; callers fall
+// back to plain bufio line reading.
+func readLineWithHistory() (line string, ok bool) {
+	return "", false
+}
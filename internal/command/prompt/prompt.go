@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+// Package prompt layers interactive TTY prompting on top of the command
+// package's arg/opt parser, so a CLI can declare required options and only
+// prompt the user for the ones missing from flags, env vars, or config.
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/focela/loom/internal/command"
+)
+
+// Option configures a single prompt call.
+type Option func(*options)
+
+type options struct {
+	def       string
+	retries   int
+	validate  func(string) error
+	maskInput bool
+}
+
+// WithDefault sets the value returned when the user enters nothing.
+func WithDefault(def string) Option {
+	return func(o *options) { o.def = def }
+}
+
+// WithRetries sets how many times an invalid answer is re-prompted before
+// giving up and returning the default.
+func WithRetries(retries int) Option {
+	return func(o *options) { o.retries = retries }
+}
+
+// WithValidate registers a validation callback; a non-nil error re-prompts.
+func WithValidate(fn func(string) error) Option {
+	return func(o *options) { o.validate = fn }
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{retries: 1}
+	for _, apply := range opts {
+		apply(o)
+	}
+	return o
+}
+
+// NonInteractiveOptName is the flag that forces prompts to fall back to
+// defaults/errors instead of reading from the TTY.
+const NonInteractiveOptName = "non-interactive"
+
+// isInteractive reports whether stdin is a TTY and --non-interactive wasn't given.
+func isInteractive() bool {
+	if command.ContainsOpt(NonInteractiveOptName) {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// Ask returns the value of name from the command parser if present;
+// otherwise, on an interactive TTY, it prompts the user with question and
+// returns their answer (validated and retried per opts). Off a TTY, or with
+// --non-interactive set, it returns the default or an empty string.
+func Ask(name, question string, opts ...Option) string {
+	if command.ContainsOpt(name) {
+		return command.GetOpt(name)
+	}
+
+	o := newOptions(opts...)
+	if !isInteractive() {
+		return o.def
+	}
+
+	for attempt := 0; attempt <= o.retries; attempt++ {
+		answer := readLine(question, o.def)
+		if answer == "" {
+			answer = o.def
+		}
+		if o.validate == nil {
+			return answer
+		}
+		if err := o.validate(answer); err == nil {
+			return answer
+		} else if attempt < o.retries {
+			fmt.Fprintln(os.Stderr, "invalid input:", err)
+		}
+	}
+	return o.def
+}
+
+// Confirm asks a yes/no question, returning defaultYes when the answer is empty.
+func Confirm(name, question string, defaultYes bool) bool {
+	def := "n"
+	if defaultYes {
+		def = "y"
+	}
+
+	answer := Ask(name, question+" [y/n]", WithDefault(def))
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// Select prompts the user to choose one of choices by number, returning the
+// chosen string. If name is already set via the command parser, that value
+// is used verbatim without prompting.
+func Select(name, question string, choices []string, opts ...Option) string {
+	if command.ContainsOpt(name) {
+		return command.GetOpt(name)
+	}
+
+	o := newOptions(opts...)
+	if !isInteractive() || len(choices) == 0 {
+		return o.def
+	}
+
+	var prompt strings.Builder
+	fmt.Fprintln(&prompt, question)
+	for i, choice := range choices {
+		fmt.Fprintf(&prompt, "  %d) %s\n", i+1, choice)
+	}
+
+	for attempt := 0; attempt <= o.retries; attempt++ {
+		answer := readLine(prompt.String(), "")
+		for i, choice := range choices {
+			if answer == fmt.Sprintf("%d", i+1) || answer == choice {
+				return choice
+			}
+		}
+		if attempt < o.retries {
+			fmt.Fprintln(os.Stderr, "please choose one of the listed options")
+		}
+	}
+	return o.def
+}
+
+// Password prompts for a value with terminal echo suppressed, falling back
+// to the default (or an empty string) when not on an interactive TTY.
+func Password(name, question string, opts ...Option) string {
+	if command.ContainsOpt(name) {
+		return command.GetOpt(name)
+	}
+
+	o := newOptions(opts...)
+	if !isInteractive() {
+		return o.def
+	}
+
+	fmt.Fprint(os.Stderr, question+": ")
+	raw, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return o.def
+	}
+
+	answer := string(raw)
+	if answer == "" {
+		return o.def
+	}
+	return answer
+}
+
+// readLine renders question, appending the default hint if present, and
+// reads a single line of input using the line editor so arrow-key history
+// and backspace editing behave as expected on supported terminals.
+func readLine(question, def string) string {
+	hint := ""
+	if def != "" {
+		hint = fmt.Sprintf(" [%s]", def)
+	}
+	fmt.Fprint(os.Stderr, question+hint+": ")
+
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		if line, ok := readLineWithHistory(); ok {
+			return strings.TrimSpace(line)
+		}
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	line, _ := reader.ReadString('\n')
+	return strings.TrimSpace(line)
+}
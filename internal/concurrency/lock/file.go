@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// errLocked is returned by tryLockFile when the underlying advisory lock is
+// already held by another process.
+var errLocked = errors.New("lock: file is already locked")
+
+// FileMutex is a cross-process mutex backed by an advisory file lock
+// (flock(2) on Unix, LockFileEx on Windows), for CLI tools and daemons that
+// need to coordinate with other processes over shared on-disk state rather
+// than just goroutines within the same process. It exposes the same
+// Lock/Unlock/IsSafe surface as Mutex, so callers can pick per-instance
+// whether coordination is intra- or inter-process.
+type FileMutex struct {
+	path string
+	safe bool
+
+	mu   sync.Mutex // serializes this process's own Lock/Unlock/Close calls.
+	file *os.File   // opened lazily on first Lock/TryLock, nil until then.
+}
+
+// NewFile creates a FileMutex backed by the file at path, which is created
+// (though its parent directory must already exist) on first use. The
+// underlying file descriptor is opened lazily, on the first Lock/TryLock
+// call, and closed by Close.
+//
+// safe mirrors Mutex's constructor: if omitted or false, Lock/Unlock/TryLock
+// are no-ops and IsSafe reports false, so the same code can run uncoordinated
+// wherever only one process ever touches path.
+func NewFile(path string, safe ...bool) (*FileMutex, error) {
+	if path == "" {
+		return nil, errors.New("lock: path must not be empty")
+	}
+	return &FileMutex{
+		path: path,
+		safe: len(safe) > 0 && safe[0],
+	}, nil
+}
+
+// IsSafe reports whether fm was constructed with safe mode enabled.
+func (fm *FileMutex) IsSafe() bool {
+	return fm.safe
+}
+
+// Lock blocks until fm's file lock is acquired. It is a no-op if fm is not
+// in safe mode.
+func (fm *FileMutex) Lock() error {
+	if !fm.safe {
+		return nil
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	f, err := fm.ensureOpenLocked()
+	if err != nil {
+		return err
+	}
+	return lockFile(f)
+}
+
+// Unlock releases fm's file lock. It is a no-op if fm is not in safe mode.
+func (fm *FileMutex) Unlock() error {
+	if !fm.safe {
+		return nil
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	if fm.file == nil {
+		return nil
+	}
+	return unlockFile(fm.file)
+}
+
+// TryLock attempts to acquire fm's file lock, retrying at a short interval
+// until it succeeds or ctx is done. It is a no-op if fm is not in safe mode.
+func (fm *FileMutex) TryLock(ctx context.Context) error {
+	if !fm.safe {
+		return nil
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	f, err := fm.ensureOpenLocked()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		err := tryLockFile(f)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errLocked) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WithTimeout is a convenience wrapper around TryLock using a context that
+// is cancelled after d.
+func (fm *FileMutex) WithTimeout(d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return fm.TryLock(ctx)
+}
+
+// Close releases fm's file lock, if held, and closes the underlying file
+// descriptor. fm must not be used again after Close.
+func (fm *FileMutex) Close() error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	if fm.file == nil {
+		return nil
+	}
+
+	var unlockErr error
+	if fm.safe {
+		unlockErr = unlockFile(fm.file)
+	}
+	closeErr := fm.file.Close()
+	fm.file = nil
+
+	if unlockErr != nil {
+		return unlockErr
+	}
+	return closeErr
+}
+
+// ensureOpenLocked lazily opens fm.file. Callers must hold fm.mu.
+func (fm *FileMutex) ensureOpenLocked() (*os.File, error) {
+	if fm.file != nil {
+		return fm.file, nil
+	}
+
+	f, err := os.OpenFile(fm.path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("lock: open %s: %w", fm.path, err)
+	}
+	fm.file = f
+	return f, nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build unix
+
+package lock
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile blocks until an exclusive flock(2) lock on f is acquired.
+func lockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+}
+
+// tryLockFile attempts to acquire an exclusive flock(2) lock on f without
+// blocking, returning errLocked if another process already holds it.
+func tryLockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return errLocked
+	}
+	return err
+}
+
+// unlockFile releases f's flock(2) lock.
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+//go:build windows
+
+package lock
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockFile blocks until an exclusive LockFileEx lock on f is acquired.
+func lockFile(f *os.File) error {
+	ol := new(windows.Overlapped)
+	return windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK, 0, 1, 0, ol)
+}
+
+// tryLockFile attempts to acquire an exclusive LockFileEx lock on f without
+// blocking, returning errLocked if another process already holds it.
+func tryLockFile(f *os.File) error {
+	ol := new(windows.Overlapped)
+	flags := uint32(windows.LOCKFILE_EXCLUSIVE_LOCK | windows.LOCKFILE_FAIL_IMMEDIATELY)
+	err := windows.LockFileEx(windows.Handle(f.Fd()), flags, 0, 1, 0, ol)
+	if err == windows.ERROR_LOCK_VIOLATION {
+		return errLocked
+	}
+	return err
+}
+
+// unlockFile releases f's LockFileEx lock.
+func unlockFile(f *os.File) error {
+	ol := new(windows.Overlapped)
+	return windows.UnlockFileEx(windows.Handle(f.Fd()), 0, 1, 0, ol)
+}
@@ -2,9 +2,11 @@
 // Internal use only. Unauthorized use is prohibited.
 // Contact: opensource@focela.com
 
-// Package lock provides a mutex implementation with the ability to enable or disable
+// Package lock provides mutex implementations with the ability to enable or disable
 // concurrent safety as needed. This allows for creating mutex objects that can be
-// selectively used for synchronization based on application requirements.
+// selectively used for synchronization based on application requirements. Mutex
+// coordinates goroutines within a single process; FileMutex, in file.go, coordinates
+// across processes via an advisory lock on a shared file.
 package lock
 
 import (
@@ -35,4 +35,7 @@ const (
 
 	// StackFilterKeyForAltura represents the stack filter key used in the Altura package.
 	StackFilterKeyForAltura = "github.com/focela/altura/"
+
+	// StackFilterKeyForLoom represents the stack filter key used in this module.
+	StackFilterKeyForLoom = "github.com/focela/loom/"
 )
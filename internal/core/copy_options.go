@@ -0,0 +1,276 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package core provides essential utilities and foundational tools for the application.
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/focela/loom/internal/core/copyset"
+)
+
+// Copier overrides how CopyWithOptions copies values of a specific type,
+// for types the caller doesn't own and can't implement Interface on
+// directly. It receives the source value and returns the value to store in
+// the copy; RegisterCopier's built-ins return a shared reference or a reset
+// zero value rather than attempting a field-by-field copy, since that's
+// what these types actually need.
+type Copier func(src reflect.Value) reflect.Value
+
+var (
+	copiersMu sync.RWMutex
+	copiers   = make(map[reflect.Type]Copier)
+)
+
+// RegisterCopier installs fn as the Copier used by CopyWithOptions whenever
+// it encounters a value of type t, replacing whatever Copier (if any) was
+// previously registered for t.
+func RegisterCopier(t reflect.Type, fn Copier) {
+	copiersMu.Lock()
+	defer copiersMu.Unlock()
+	copiers[t] = fn
+}
+
+func lookupCopier(t reflect.Type) (Copier, bool) {
+	copiersMu.RLock()
+	defer copiersMu.RUnlock()
+	c, ok := copiers[t]
+	return c, ok
+}
+
+func init() {
+	// time.Time's unexported fields are safe to share: the type is immutable
+	// in practice, so there is no aliasing hazard in returning it as-is.
+	RegisterCopier(reflect.TypeOf(time.Time{}), func(src reflect.Value) reflect.Value {
+		return src
+	})
+	// *regexp.Regexp is immutable after Compile; share the pointer instead of
+	// trying to clone its unexported internal program.
+	RegisterCopier(reflect.TypeOf(&regexp.Regexp{}), func(src reflect.Value) reflect.Value {
+		return src
+	})
+	// A copied mutex must start unlocked, not carry over the original's state.
+	RegisterCopier(reflect.TypeOf(sync.Mutex{}), func(src reflect.Value) reflect.Value {
+		return reflect.Zero(src.Type())
+	})
+	RegisterCopier(reflect.TypeOf(sync.RWMutex{}), func(src reflect.Value) reflect.Value {
+		return reflect.Zero(src.Type())
+	})
+	// atomic.Value cannot be copied by value assignment after first use; copy
+	// its held value instead via Load/Store.
+	RegisterCopier(reflect.TypeOf(atomic.Value{}), func(src reflect.Value) reflect.Value {
+		srcVal := src.Interface().(atomic.Value)
+		var out atomic.Value
+		if v := srcVal.Load(); v != nil {
+			out.Store(v)
+		}
+		return reflect.ValueOf(out)
+	})
+	// reflect.Value describes a value rather than holding struct state worth
+	// cloning; returning it as-is matches what callers expect.
+	RegisterCopier(reflect.TypeOf(reflect.Value{}), func(src reflect.Value) reflect.Value {
+		return src
+	})
+}
+
+// CopyOptions controls CopyWithOptions, extending the plain Copy/copyRecursive
+// path with depth limiting, unexported-field handling, and cycle notification.
+type CopyOptions struct {
+	// MaxDepth caps how many levels of pointers/interfaces CopyWithOptions
+	// descends into before it stops recursing and shares the original value
+	// instead. Zero means unlimited.
+	MaxDepth int
+	// SkipUnexported, when true, leaves unexported struct fields at their
+	// zero value instead of copying them via unsafe.Pointer.
+	SkipUnexported bool
+	// OnCycle, if non-nil, is called with a dotted path describing each
+	// self-reference CopyWithOptions detects (a pointer, slice, or map
+	// already visited earlier in the same call), before it reuses the
+	// already-allocated copy in place of recursing again.
+	OnCycle func(path string)
+}
+
+// copyCtx threads CopyOptions, a visited-reference set, and the current
+// depth through one CopyWithOptions call.
+type copyCtx struct {
+	opts    CopyOptions
+	visited copyset.Set
+	depth   int
+}
+
+// CopyWithOptions creates a deep copy of src like Copy, but honors opts: a
+// self-referential graph is preserved instead of recursing forever, any
+// registered Copier takes precedence over field-by-field copying, and a
+// chan or func without one reports an error instead of silently producing a
+// zero value.
+func CopyWithOptions(src interface{}, opts CopyOptions) (interface{}, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	switch r := src.(type) {
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64,
+		complex64, complex128,
+		string, bool:
+		return r, nil
+	case Interface:
+		return r.DeepCopy(), nil
+	default:
+		original := reflect.ValueOf(src)
+		dst := reflect.New(original.Type()).Elem()
+
+		ctx := &copyCtx{opts: opts, visited: make(copyset.Set)}
+		if err := ctx.copyRecursive(original, dst, original.Type().String()); err != nil {
+			return nil, err
+		}
+		return dst.Interface(), nil
+	}
+}
+
+func (c *copyCtx) copyRecursive(original, cpy reflect.Value, path string) error {
+	if !original.IsValid() {
+		return nil
+	}
+
+	if copier, ok := lookupCopier(original.Type()); ok {
+		cpy.Set(copier(original))
+		return nil
+	}
+
+	if original.CanInterface() && !original.IsZero() {
+		if copier, ok := original.Interface().(Interface); ok {
+			cpy.Set(reflect.ValueOf(copier.DeepCopy()))
+			return nil
+		}
+	}
+
+	if c.opts.MaxDepth > 0 && c.depth >= c.opts.MaxDepth {
+		cpy.Set(original)
+		return nil
+	}
+
+	switch original.Kind() {
+	case reflect.Ptr:
+		if original.IsNil() {
+			return nil
+		}
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			if c.opts.OnCycle != nil {
+				c.opts.OnCycle(path)
+			}
+			cpy.Set(existing)
+			return nil
+		}
+		copyPtr := reflect.New(original.Type().Elem())
+		c.visited[key] = copyPtr
+		cpy.Set(copyPtr)
+		c.depth++
+		err := c.copyRecursive(original.Elem(), copyPtr.Elem(), path+".*")
+		c.depth--
+		return err
+
+	case reflect.Interface:
+		if original.IsNil() {
+			return nil
+		}
+		originalValue := original.Elem()
+		copyValue := reflect.New(originalValue.Type()).Elem()
+		if err := c.copyRecursive(originalValue, copyValue, path); err != nil {
+			return err
+		}
+		cpy.Set(copyValue)
+		return nil
+
+	case reflect.Struct:
+		return c.copyStruct(original, cpy, path)
+
+	case reflect.Slice:
+		if original.IsNil() {
+			return nil
+		}
+		key := copyset.SliceKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return nil
+		}
+		newSlice := reflect.MakeSlice(original.Type(), original.Len(), original.Cap())
+		c.visited[key] = newSlice
+		cpy.Set(newSlice)
+		for i := 0; i < original.Len(); i++ {
+			if err := c.copyRecursive(original.Index(i), newSlice.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		if original.IsNil() {
+			return nil
+		}
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return nil
+		}
+		newMap := reflect.MakeMap(original.Type())
+		c.visited[key] = newMap
+		cpy.Set(newMap)
+		for _, key := range original.MapKeys() {
+			copyValue := reflect.New(original.MapIndex(key).Type()).Elem()
+			if err := c.copyRecursive(original.MapIndex(key), copyValue, fmt.Sprintf("%s[%v]", path, key.Interface())); err != nil {
+				return err
+			}
+			copiedKey, err := CopyWithOptions(key.Interface(), c.opts)
+			if err != nil {
+				return err
+			}
+			newMap.SetMapIndex(reflect.ValueOf(copiedKey), copyValue)
+		}
+		return nil
+
+	case reflect.Chan, reflect.Func:
+		return fmt.Errorf("core: cannot deep copy %s (no Copier registered for %s at %s)", original.Kind(), original.Type(), path)
+
+	default:
+		cpy.Set(original)
+		return nil
+	}
+}
+
+// copyStruct copies a struct field by field, honoring SkipUnexported for
+// fields the plain copyRecursive/copyStruct always skips.
+func (c *copyCtx) copyStruct(original, cpy reflect.Value, path string) error {
+	typ := original.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldPath := path + "." + field.Name
+
+		if field.PkgPath != "" {
+			if c.opts.SkipUnexported || !original.Field(i).CanAddr() {
+				continue
+			}
+			srcField := reflect.NewAt(field.Type, unsafe.Pointer(original.Field(i).UnsafeAddr())).Elem()
+			dstField := reflect.NewAt(field.Type, unsafe.Pointer(cpy.Field(i).UnsafeAddr())).Elem()
+			if err := c.copyRecursive(srcField, dstField, fieldPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := c.copyRecursive(original.Field(i), cpy.Field(i), fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
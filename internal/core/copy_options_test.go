@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package core
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+// TestCopyWithOptions_AtomicValue pins the registered atomic.Value Copier: it
+// must copy the held value via Load/Store rather than asserting to a
+// non-addressable atomic.Value and calling its pointer-receiver Load method
+// directly, which fails to compile.
+func TestCopyWithOptions_AtomicValue(t *testing.T) {
+	type holder struct {
+		V atomic.Value
+	}
+	var src holder
+	src.V.Store("original")
+
+	out, err := CopyWithOptions(src, CopyOptions{})
+	if err != nil {
+		t.Fatalf("CopyWithOptions returned error: %v", err)
+	}
+
+	dst := out.(holder)
+	if got := dst.V.Load(); got != "original" {
+		t.Fatalf("copied atomic.Value.Load() = %v, want %q", got, "original")
+	}
+
+	dst.V.Store("changed")
+	if got := src.V.Load(); got != "original" {
+		t.Fatalf("copy shares state with original: src.V.Load() = %v, want unchanged %q", got, "original")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package copyset provides the cycle-detection primitive shared by this
+// repo's deep-copy engines (internal/kernel, internal/core,
+// internal/core/deepcopy): a "visited" set that maps an already-copied
+// reference to the reflect.Value holding its copy, so a cyclic or
+// shared structure is copied once and every reference to it resolves to
+// that same copy.
+//
+// This was previously reinvented independently by each engine as a local
+// uintptr-keyed map, and the same bug was shipped and fixed three times as a
+// result: Pointer() alone doesn't identify a slice, because two slices can
+// share a backing array (one a sub-slice of the other) while denoting a
+// different length of data. Key folds the length into the identity so that
+// case is handled once, here, instead of in every engine that needs it.
+package copyset
+
+import "reflect"
+
+// Key identifies a single reference visited during one deep-copy
+// traversal. Address alone isn't enough: Go backs every zero-size
+// allocation with the same runtime address ("zerobase"), so a *struct{}
+// field and an unrelated empty slice field can share a Pointer() despite
+// being neither the same reference nor the same type; Type rules that out.
+// Ptr, Map, and Chan references are identified by address and type alone
+// (Len is left at its zero value); Slice references need Len too, since a
+// sub-slice aliases its parent's address and type while denoting a distinct
+// length of data.
+type Key struct {
+	Addr uintptr
+	Type reflect.Type
+	Len  int
+}
+
+// RefKey returns the Key for a Ptr, Map, or Chan reflect.Value. The caller
+// must have already excluded the nil case: a nil reference's Pointer() is 0
+// and isn't a meaningful identity to key on.
+func RefKey(v reflect.Value) Key {
+	return Key{Addr: v.Pointer(), Type: v.Type()}
+}
+
+// SliceKey returns the Key for a Slice reflect.Value, folding in its length
+// so an aliased sub-slice of a longer slice isn't resolved to the longer
+// slice's copy. The caller must have already excluded the nil case.
+func SliceKey(v reflect.Value) Key {
+	return Key{Addr: v.Pointer(), Type: v.Type(), Len: v.Len()}
+}
+
+// Set tracks the copy produced so far for each Key visited during a single
+// deep-copy call.
+type Set map[Key]reflect.Value
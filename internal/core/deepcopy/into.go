@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package deepcopy
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DeepCopyIntoer is implemented by types that can deep-copy themselves into a
+// caller-provided destination without allocating a fresh top-level value,
+// mirroring the DeepCopyInto method Kubernetes-style generated code attaches
+// to API types.
+type DeepCopyIntoer interface {
+	// DeepCopyInto copies the receiver into dst, which must be a non-nil
+	// pointer of the receiver's type.
+	DeepCopyInto(dst interface{})
+}
+
+// DeepCopyInto deep-copies src into dst, a non-nil pointer whose element type
+// matches src, instead of allocating and returning a fresh value like Copy.
+// This avoids an allocation per call, which matters when a value (a config or
+// DTO) is copied on every request.
+//
+// If src implements DeepCopyIntoer, its DeepCopyInto method is used directly.
+// Otherwise DeepCopyInto validates dst and runs the same recursive copy
+// copyRecursive uses, writing through dst's reflect.Value instead of a freshly
+// allocated one.
+func DeepCopyInto(src, dst interface{}) error {
+	if dst == nil {
+		return fmt.Errorf("deepcopy.DeepCopyInto: dst must be a non-nil pointer, got nil")
+	}
+
+	dstVal := reflect.ValueOf(dst)
+	if dstVal.Kind() != reflect.Ptr || dstVal.IsNil() {
+		return fmt.Errorf("deepcopy.DeepCopyInto: dst must be a non-nil pointer, got %T", dst)
+	}
+
+	if src == nil {
+		return nil
+	}
+
+	if copier, ok := src.(DeepCopyIntoer); ok {
+		copier.DeepCopyInto(dst)
+		return nil
+	}
+
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Type() != dstVal.Elem().Type() {
+		return fmt.Errorf("deepcopy.DeepCopyInto: dst element type %s does not match src type %s", dstVal.Elem().Type(), srcVal.Type())
+	}
+
+	switch src.(type) {
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64,
+		complex64, complex128,
+		string, bool:
+		dstVal.Elem().Set(srcVal)
+		return nil
+	}
+
+	if v, ok := src.(Interface); ok {
+		dstVal.Elem().Set(reflect.ValueOf(v.DeepCopy()))
+		return nil
+	}
+
+	copyRecursive(srcVal, dstVal.Elem())
+	return nil
+}
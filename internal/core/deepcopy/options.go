@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+// Package deepcopy provides functionality for creating deep copies of Go data structures
+// using reflection. It can handle complex nested structures like maps, slices, and structs,
+// as well as basic types.
+package deepcopy
+
+import (
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/focela/loom/internal/core/copyset"
+)
+
+// Options controls CopyWithOptions, extending the plain Copy/copyRecursive
+// path with per-type overrides, unexported-field support, and cycle safety.
+type Options struct {
+	// Copiers overrides the copy behavior for a specific type, for types the
+	// caller doesn't own and can't implement Interface on directly
+	// (time.Time, *big.Int, sync.Mutex, ...).
+	Copiers map[reflect.Type]func(reflect.Value) reflect.Value
+	// CopyUnexported, when true, copies unexported struct fields via
+	// unsafe.Pointer instead of the default of leaving them at their zero value.
+	CopyUnexported bool
+	// SkipTypes lists types that should be shared with the original rather
+	// than copied (channels, sync.Mutex, *os.File, ...): the destination
+	// holds the same value as the source instead of a fresh copy.
+	SkipTypes map[reflect.Type]bool
+}
+
+// CopyWithOptions creates a deep copy of src like Copy, but honors opts:
+// cycles and shared references are preserved instead of recursing forever,
+// unexported fields are optionally copied, and Copiers/SkipTypes let the
+// caller override per-type behavior.
+func CopyWithOptions(src interface{}, opts Options) interface{} {
+	if src == nil {
+		return nil
+	}
+
+	switch r := src.(type) {
+	case int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64,
+		complex64, complex128,
+		string, bool:
+		return r
+	case Interface:
+		return r.DeepCopy()
+	default:
+		original := reflect.ValueOf(src)
+		dst := reflect.New(original.Type()).Elem()
+
+		c := &optionsCopier{opts: opts, visited: make(copyset.Set)}
+		c.copyRecursive(original, dst)
+		return dst.Interface()
+	}
+}
+
+// optionsCopier threads Options and a visited-set through a CopyWithOptions call.
+type optionsCopier struct {
+	opts    Options
+	visited copyset.Set
+}
+
+func (c *optionsCopier) copyRecursive(original, cpy reflect.Value) {
+	if !original.IsValid() {
+		return
+	}
+
+	if copier, ok := c.opts.Copiers[original.Type()]; ok {
+		cpy.Set(copier(original))
+		return
+	}
+	if c.opts.SkipTypes[original.Type()] {
+		cpy.Set(original)
+		return
+	}
+
+	if original.CanInterface() && !original.IsZero() {
+		if copier, ok := original.Interface().(Interface); ok {
+			cpy.Set(reflect.ValueOf(copier.DeepCopy()))
+			return
+		}
+	}
+
+	switch original.Kind() {
+	case reflect.Ptr:
+		if original.IsNil() {
+			return
+		}
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return
+		}
+		copyPtr := reflect.New(original.Type().Elem())
+		c.visited[key] = copyPtr
+		cpy.Set(copyPtr)
+		c.copyRecursive(original.Elem(), copyPtr.Elem())
+
+	case reflect.Interface:
+		if original.IsNil() {
+			return
+		}
+		originalValue := original.Elem()
+		copyValue := reflect.New(originalValue.Type()).Elem()
+		c.copyRecursive(originalValue, copyValue)
+		cpy.Set(copyValue)
+
+	case reflect.Struct:
+		c.copyStruct(original, cpy)
+
+	case reflect.Slice:
+		if original.IsNil() {
+			return
+		}
+		key := copyset.SliceKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return
+		}
+		newSlice := reflect.MakeSlice(original.Type(), original.Len(), original.Cap())
+		c.visited[key] = newSlice
+		cpy.Set(newSlice)
+		for i := 0; i < original.Len(); i++ {
+			c.copyRecursive(original.Index(i), newSlice.Index(i))
+		}
+
+	case reflect.Map:
+		if original.IsNil() {
+			return
+		}
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return
+		}
+		newMap := reflect.MakeMap(original.Type())
+		c.visited[key] = newMap
+		cpy.Set(newMap)
+		for _, k := range original.MapKeys() {
+			copyValue := reflect.New(original.MapIndex(k).Type()).Elem()
+			c.copyRecursive(original.MapIndex(k), copyValue)
+			copiedKey := CopyWithOptions(k.Interface(), c.opts)
+			newMap.SetMapIndex(reflect.ValueOf(copiedKey), copyValue)
+		}
+
+	case reflect.Chan, reflect.Func:
+		// No override matched above: without an explicit SkipTypes/Copiers
+		// entry, copy the reference as-is rather than losing it (the old
+		// IsZero-guarded default branch would silently drop these).
+		if !original.IsZero() {
+			cpy.Set(original)
+		}
+
+	default:
+		cpy.Set(original)
+	}
+}
+
+// copyStruct copies a struct field by field, honoring CopyUnexported for
+// fields the plain copyRecursive/copyStruct always skips.
+func (c *optionsCopier) copyStruct(original, cpy reflect.Value) {
+	// Special case for time.Time: its unexported fields are safe to copy by
+	// value assignment, so it doesn't need CopyUnexported to round-trip.
+	if t, ok := original.Interface().(time.Time); ok {
+		cpy.Set(reflect.ValueOf(t))
+		return
+	}
+
+	typ := original.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath == "" {
+			c.copyRecursive(original.Field(i), cpy.Field(i))
+			continue
+		}
+		if !c.opts.CopyUnexported || !original.Field(i).CanAddr() {
+			continue
+		}
+		srcField := reflect.NewAt(field.Type, unsafe.Pointer(original.Field(i).UnsafeAddr())).Elem()
+		dstField := reflect.NewAt(field.Type, unsafe.Pointer(cpy.Field(i).UnsafeAddr())).Elem()
+		c.copyRecursive(srcField, dstField)
+	}
+}
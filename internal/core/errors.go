@@ -36,16 +36,16 @@ const (
 var stackModeConfigured = StackModeBrief
 
 // init initializes the error stack mode configuration.
-// It reads settings from command line arguments or environment variables.
+// It reads settings from the command package's CLI flag/env var/config file binding.
 func init() {
 	// Handle deprecated brief stack mode setting.
-	briefSetting := command.GetOptWithEnv(commandEnvKeyForBrief)
+	briefSetting := command.GetString(commandEnvKeyForBrief)
 	if briefSetting == "1" || briefSetting == "true" {
 		stackModeConfigured = StackModeBrief
 	}
 
-	// Handle stack mode setting from environment or arguments.
-	stackModeSetting := command.GetOptWithEnv(commandEnvKeyForStackMode)
+	// Handle stack mode setting from environment, flags, or config file.
+	stackModeSetting := command.GetString(commandEnvKeyForStackMode)
 	if stackModeSetting != "" {
 		stackModeSettingMode := StackMode(stackModeSetting)
 		switch stackModeSettingMode {
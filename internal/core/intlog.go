@@ -10,8 +10,12 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/focela/loom/internal/debug"
@@ -24,40 +28,126 @@ const (
 
 // Print logs messages in debug mode with newline.
 func Print(ctx context.Context, v ...interface{}) {
-	doPrint(ctx, fmt.Sprint(v...), false)
+	doPrint(ctx, fmt.Sprint(v...), false, nil)
 }
 
 // Printf logs formatted messages in debug mode.
 func Printf(ctx context.Context, format string, v ...interface{}) {
-	doPrint(ctx, fmt.Sprintf(format, v...), false)
+	doPrint(ctx, fmt.Sprintf(format, v...), false, nil)
 }
 
 // PrintFunc executes and logs output from a function in debug mode.
 func PrintFunc(ctx context.Context, f func() string) {
 	if s := f(); s != "" {
-		doPrint(ctx, s, false)
+		doPrint(ctx, s, false, nil)
 	}
 }
 
+// PrintKV logs msg in debug mode along with structured key/value pairs
+// (kvs alternating key, value, key, value, ...), the way Error/ErrorKV
+// differ only in severity. The Sink decides how (or whether) to render kvs;
+// the built-in stdout Sink appends them as "key=value" pairs.
+func PrintKV(ctx context.Context, msg string, kvs ...interface{}) {
+	doPrint(ctx, msg, false, kvs)
+}
+
 // Error logs error messages in debug mode with newline.
 func Error(ctx context.Context, v ...interface{}) {
-	doPrint(ctx, fmt.Sprint(v...), true)
+	doPrint(ctx, fmt.Sprint(v...), true, nil)
 }
 
 // Errorf logs formatted error messages in debug mode.
 func Errorf(ctx context.Context, format string, v ...interface{}) {
-	doPrint(ctx, fmt.Sprintf(format, v...), true)
+	doPrint(ctx, fmt.Sprintf(format, v...), true, nil)
 }
 
 // ErrorFunc executes and logs error output from a function in debug mode.
 func ErrorFunc(ctx context.Context, f func() string) {
 	if s := f(); s != "" {
-		doPrint(ctx, s, true)
+		doPrint(ctx, s, true, nil)
 	}
 }
 
-// doPrint handles the actual printing of log messages.
-func doPrint(ctx context.Context, content string, stack bool) {
+// ErrorKV logs msg as an error in debug mode along with structured
+// key/value pairs. See PrintKV.
+func ErrorKV(ctx context.Context, msg string, kvs ...interface{}) {
+	doPrint(ctx, msg, true, kvs)
+}
+
+// Level identifies the severity of one Sink.Emit call.
+type Level int
+
+// Supported levels, matching the Print/Error split every logging function
+// in this file already makes.
+const (
+	LevelPrint Level = iota
+	LevelError
+)
+
+// Sink receives every Print/Error call this package makes. doPrint invokes
+// the currently registered Sink unconditionally; it is up to each Sink
+// implementation to decide whether utils.IsDebugEnabled applies to it, the
+// way the built-in stdout Sink does and the OTel Sink deliberately does not,
+// so span events keep flowing in production even with debug logging off.
+type Sink interface {
+	Emit(ctx context.Context, level Level, msg string, kvs []interface{}, stack string)
+}
+
+var (
+	sinkMu      sync.RWMutex
+	currentSink Sink = stdoutSink{}
+)
+
+// SetSink replaces the Sink that Print/Error calls are emitted to, so
+// callers opting into OTel or another destination don't need every call
+// site in this package rewritten. A nil Sink restores the default stdout
+// Sink. Use MultiSink to keep stdout output active alongside another Sink.
+func SetSink(s Sink) {
+	sinkMu.Lock()
+	defer sinkMu.Unlock()
+	if s == nil {
+		s = stdoutSink{}
+	}
+	currentSink = s
+}
+
+func getSink() Sink {
+	sinkMu.RLock()
+	defer sinkMu.RUnlock()
+	return currentSink
+}
+
+// StdoutSink returns the built-in Sink used by default: the historical
+// stdout line format, gated by utils.IsDebugEnabled.
+func StdoutSink() Sink { return stdoutSink{} }
+
+// NewOTelSink returns a Sink that records each call as a span event (via
+// span.AddEvent) on the recording span in ctx, if any, promoting
+// LevelError entries to span.RecordError plus span.SetStatus(codes.Error).
+// It is not gated by utils.IsDebugEnabled: tracing is expected to run in
+// production regardless of whether debug logging is on.
+func NewOTelSink() Sink { return otelSink{} }
+
+// MultiSink returns a Sink that calls each of sinks' Emit in order, so
+// e.g. SetSink(MultiSink(StdoutSink(), NewOTelSink())) keeps the default
+// stdout output while also forwarding to OpenTelemetry.
+func MultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+type multiSink []Sink
+
+func (m multiSink) Emit(ctx context.Context, level Level, msg string, kvs []interface{}, stack string) {
+	for _, s := range m {
+		s.Emit(ctx, level, msg, kvs, stack)
+	}
+}
+
+// stdoutSink reproduces this package's historical "print a formatted line
+// to stdout" behavior.
+type stdoutSink struct{}
+
+func (stdoutSink) Emit(ctx context.Context, level Level, msg string, kvs []interface{}, stack string) {
 	if !utils.IsDebugEnabled() {
 		return
 	}
@@ -72,17 +162,85 @@ func doPrint(ctx context.Context, content string, stack bool) {
 		buffer.WriteString(traceID + " ")
 	}
 
-	buffer.WriteString(content)
+	buffer.WriteString(msg)
+	buffer.WriteString(formatKV(kvs))
 	buffer.WriteString("\n")
 
-	if stack {
+	if level == LevelError && stack != "" {
 		buffer.WriteString("Caller Stack:\n")
-		buffer.WriteString(debug.StackWithFilter([]string{stackFilterKey}))
+		buffer.WriteString(stack)
 	}
 
 	fmt.Print(buffer.String())
 }
 
+// otelSink bridges Print/Error calls onto the active OTel span in ctx.
+type otelSink struct{}
+
+func (otelSink) Emit(ctx context.Context, level Level, msg string, kvs []interface{}, stack string) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(msg, trace.WithAttributes(kvAttributes(kvs)...))
+	if level == LevelError {
+		span.RecordError(fmt.Errorf("%s", msg), trace.WithAttributes(kvAttributes(kvs)...))
+		span.SetStatus(codes.Error, msg)
+	}
+}
+
+// doPrint formats content and dispatches it to the currently registered
+// Sink. The filtered caller stack is computed here, not inside a Sink,
+// because debug.StackWithFilter/CallerWithFilter filter frames by file path
+// against stackFilterKey, which only matches frames in this file.
+func doPrint(ctx context.Context, content string, isError bool, kvs []interface{}) {
+	level := LevelPrint
+	var stack string
+	if isError {
+		level = LevelError
+		stack = debug.StackWithFilter([]string{stackFilterKey})
+	}
+	getSink().Emit(ctx, level, content, kvs, stack)
+}
+
+// formatKV renders kvs (alternating key, value, ...) as " key=value ..." for
+// the stdout Sink. An odd trailing key with no value is rendered as-is.
+func formatKV(kvs []interface{}) string {
+	if len(kvs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for i := 0; i < len(kvs); i += 2 {
+		b.WriteString(" ")
+		if i+1 < len(kvs) {
+			fmt.Fprintf(&b, "%v=%v", kvs[i], kvs[i+1])
+		} else {
+			fmt.Fprintf(&b, "%v", kvs[i])
+		}
+	}
+	return b.String()
+}
+
+// kvAttributes converts kvs (alternating key, value, ...) into OTel
+// attributes, stringifying every value via fmt.Sprint since kvs is typed
+// interface{} rather than one of attribute.KeyValue's specific Go types.
+func kvAttributes(kvs []interface{}) []attribute.KeyValue {
+	if len(kvs) == 0 {
+		return nil
+	}
+	attrs := make([]attribute.KeyValue, 0, (len(kvs)+1)/2)
+	for i := 0; i < len(kvs); i += 2 {
+		key := fmt.Sprint(kvs[i])
+		if i+1 < len(kvs) {
+			attrs = append(attrs, attribute.String(key, fmt.Sprint(kvs[i+1])))
+		} else {
+			attrs = append(attrs, attribute.String(key, ""))
+		}
+	}
+	return attrs
+}
+
 // getTraceID retrieves the trace ID from the context.
 func getTraceID(ctx context.Context) string {
 	if ctx == nil {
@@ -0,0 +1,485 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package introspection
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeType identifies the kind of difference a Change record describes.
+type ChangeType string
+
+const (
+	// Create marks a value present in the "to" side but not the "from" side.
+	Create ChangeType = "create"
+	// Update marks a value present on both sides but with different content.
+	Update ChangeType = "update"
+	// Delete marks a value present in the "from" side but not the "to" side.
+	Delete ChangeType = "delete"
+)
+
+// Change describes a single difference found by Diff between two values.
+// Path is the sequence of struct field names (renamed by the `diff` tag, if
+// present), map keys, and slice element identifiers from the diffed values'
+// roots down to the differing leaf.
+type Change struct {
+	Path []string
+	Type ChangeType
+	From interface{}
+	To   interface{}
+}
+
+// diffTagKey is the struct tag Diff and Patch read field names and options from.
+const diffTagKey = "diff"
+
+// diffKeyOption tags the field of a slice element's struct type that Diff
+// uses to match elements by value instead of by position.
+const diffKeyOption = "key"
+
+// DiffOption configures a Diff call.
+type DiffOption func(*diffConfig)
+
+// diffConfig holds the resolved options for one Diff call.
+type diffConfig struct {
+	ignorePaths map[string]bool
+}
+
+// IgnorePath excludes the given dot-separated paths (matching Change.Path
+// joined with ".") and everything nested beneath them from the returned changeset.
+func IgnorePath(paths ...string) DiffOption {
+	return func(c *diffConfig) {
+		for _, p := range paths {
+			c.ignorePaths[p] = true
+		}
+	}
+}
+
+// visitedPtr identifies a pointer, map, or slice header already entered
+// during one Diff call, so a cycle in the "from" value does not recurse forever.
+type visitedPtr struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// differ threads the resolved options and a visited set through one Diff call.
+type differ struct {
+	cfg     *diffConfig
+	visited map[visitedPtr]bool
+	changes []Change
+}
+
+// Diff walks a and b via reflection and returns every Change between them.
+// It recurses into nested structs (honoring a `diff:"name,omitempty,-"`
+// struct tag: rename the path segment, skip the field when the zero value on
+// both sides, or skip it entirely with "-"), maps (keyed by the stringified
+// map key), and slices (positionally, unless the element type has a field
+// tagged `diff:",key"`, in which case elements are matched by that field's
+// value instead).
+//
+// time.Time and other types implementing encoding.TextMarshaler are compared
+// by their marshaled form rather than field-by-field, since their useful
+// state often lives in unexported fields.
+func Diff(a, b interface{}, opts ...DiffOption) ([]Change, error) {
+	cfg := &diffConfig{ignorePaths: map[string]bool{}}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	d := &differ{cfg: cfg, visited: map[visitedPtr]bool{}}
+	av := OriginValueAndKind(a).OriginValue
+	bv := OriginValueAndKind(b).OriginValue
+	if err := d.diff(nil, av, bv); err != nil {
+		return nil, err
+	}
+	return d.changes, nil
+}
+
+// record appends a Change for path unless the path (or an ancestor of it)
+// was excluded via IgnorePath.
+func (d *differ) record(path []string, typ ChangeType, from, to interface{}) {
+	if d.cfg.ignorePaths[strings.Join(path, ".")] {
+		return
+	}
+	d.changes = append(d.changes, Change{Path: append([]string(nil), path...), Type: typ, From: from, To: to})
+}
+
+// diff compares a and b (already dereferenced to their origin Kind where one
+// side is valid) and records every difference found under path.
+func (d *differ) diff(path []string, a, b reflect.Value) error {
+	if !a.IsValid() && !b.IsValid() {
+		return nil
+	}
+	if !a.IsValid() {
+		d.record(path, Create, nil, valueToInterface(b))
+		return nil
+	}
+	if !b.IsValid() {
+		d.record(path, Delete, valueToInterface(a), nil)
+		return nil
+	}
+
+	if a.Kind() == reflect.Ptr || a.Kind() == reflect.Interface {
+		return d.diffPointerOrInterface(path, a, b)
+	}
+
+	if tm, ok := asTextMarshaler(a); ok {
+		return d.diffTextMarshaler(path, tm, a, b)
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		return d.diffStruct(path, a, b)
+	case reflect.Map:
+		return d.diffMap(path, a, b)
+	case reflect.Slice, reflect.Array:
+		return d.diffSlice(path, a, b)
+	default:
+		return d.diffLeaf(path, a, b)
+	}
+}
+
+// diffPointerOrInterface unwraps a and b one level (following nil-ness and
+// cycle tracking for pointers) and recurses into the underlying value.
+func (d *differ) diffPointerOrInterface(path []string, a, b reflect.Value) error {
+	if a.Kind() == reflect.Ptr {
+		if a.IsNil() || b.Kind() != reflect.Ptr || b.IsNil() {
+			if a.IsNil() && (b.Kind() != reflect.Ptr || b.IsNil()) {
+				return nil
+			}
+			if a.IsNil() {
+				d.record(path, Create, nil, valueToInterface(indirect(b)))
+				return nil
+			}
+			d.record(path, Delete, valueToInterface(indirect(a)), nil)
+			return nil
+		}
+
+		key := visitedPtr{addr: a.Pointer(), typ: a.Type()}
+		if d.visited[key] {
+			return nil
+		}
+		d.visited[key] = true
+		return d.diff(path, a.Elem(), b.Elem())
+	}
+
+	// Interface: unwrap, treating a nil interface like an absent value.
+	var av, bv reflect.Value
+	if !a.IsNil() {
+		av = a.Elem()
+	}
+	if !b.IsNil() {
+		bv = b.Elem()
+	}
+	return d.diff(path, av, bv)
+}
+
+// diffTextMarshaler compares a and b by their MarshalText output rather than
+// field-by-field, used for time.Time and similar value types.
+func (d *differ) diffTextMarshaler(path []string, tm encoding.TextMarshaler, a, b reflect.Value) error {
+	aText, err := tm.MarshalText()
+	if err != nil {
+		return fmt.Errorf("introspection.Diff: marshal %s: %w", strings.Join(path, "."), err)
+	}
+	bTM, ok := asTextMarshaler(b)
+	if !ok {
+		return d.diffLeaf(path, a, b)
+	}
+	bText, err := bTM.MarshalText()
+	if err != nil {
+		return fmt.Errorf("introspection.Diff: marshal %s: %w", strings.Join(path, "."), err)
+	}
+	if string(aText) != string(bText) {
+		d.record(path, Update, valueToInterface(a), valueToInterface(b))
+	}
+	return nil
+}
+
+// diffStruct recurses into a and b's exported fields, honoring the `diff` tag.
+func (d *differ) diffStruct(path []string, a, b reflect.Value) error {
+	typ := a.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, omitEmpty, skip := parseDiffTag(field)
+		if skip {
+			continue
+		}
+
+		fa, fb := a.Field(i), b.Field(i)
+		if omitEmpty && fa.IsZero() && fb.IsZero() {
+			continue
+		}
+
+		if err := d.diff(append(path, name), fa, fb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffMap recurses over the union of a and b's keys, stringified for the path.
+func (d *differ) diffMap(path []string, a, b reflect.Value) error {
+	if a.IsNil() && b.IsNil() {
+		return nil
+	}
+	if !d.enterVisited(a) {
+		return nil
+	}
+
+	keys := map[string]reflect.Value{}
+	order := make([]string, 0)
+	for _, k := range a.MapKeys() {
+		s := fmt.Sprintf("%v", k.Interface())
+		keys[s] = k
+		order = append(order, s)
+	}
+	for _, k := range b.MapKeys() {
+		s := fmt.Sprintf("%v", k.Interface())
+		if _, ok := keys[s]; !ok {
+			keys[s] = k
+			order = append(order, s)
+		}
+	}
+	sort.Strings(order)
+
+	for _, s := range order {
+		k := keys[s]
+		var av, bv reflect.Value
+		if !a.IsNil() {
+			av = a.MapIndex(k)
+		}
+		if !b.IsNil() {
+			bv = b.MapIndex(k)
+		}
+		if err := d.diff(append(path, s), av, bv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffSlice recurses over a and b's elements. If the element type has a
+// field tagged `diff:",key"`, elements are matched by that field's stringified
+// value; otherwise they are matched positionally.
+func (d *differ) diffSlice(path []string, a, b reflect.Value) error {
+	if a.Kind() == reflect.Slice {
+		if a.IsNil() && b.IsNil() {
+			return nil
+		}
+		if !d.enterVisited(a) {
+			return nil
+		}
+	}
+
+	if keyField, ok := sliceElementKeyField(a.Type()); ok {
+		return d.diffSliceByKey(path, a, b, keyField)
+	}
+	return d.diffSliceByIndex(path, a, b)
+}
+
+// diffSliceByIndex matches elements positionally.
+func (d *differ) diffSliceByIndex(path []string, a, b reflect.Value) error {
+	n := a.Len()
+	if b.Len() > n {
+		n = b.Len()
+	}
+	for i := 0; i < n; i++ {
+		var av, bv reflect.Value
+		if i < a.Len() {
+			av = a.Index(i)
+		}
+		if i < b.Len() {
+			bv = b.Index(i)
+		}
+		if err := d.diff(append(path, fmt.Sprintf("%d", i)), av, bv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffSliceByKey matches elements by the stringified value of keyField.
+func (d *differ) diffSliceByKey(path []string, a, b reflect.Value, keyField string) error {
+	aIdx := indexSliceByKey(a, keyField)
+	bIdx := indexSliceByKey(b, keyField)
+
+	order := make([]string, 0, len(aIdx))
+	for k := range aIdx {
+		order = append(order, k)
+	}
+	for k := range bIdx {
+		if _, ok := aIdx[k]; !ok {
+			order = append(order, k)
+		}
+	}
+	sort.Strings(order)
+
+	for _, k := range order {
+		var av, bv reflect.Value
+		if i, ok := aIdx[k]; ok {
+			av = a.Index(i)
+		}
+		if i, ok := bIdx[k]; ok {
+			bv = b.Index(i)
+		}
+		if err := d.diff(append(path, k), av, bv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffLeaf compares two non-container values for equality.
+func (d *differ) diffLeaf(path []string, a, b reflect.Value) error {
+	av, aok := ValueToInterface(a)
+	bv, bok := ValueToInterface(b)
+	if !aok || !bok {
+		return nil
+	}
+	if !reflect.DeepEqual(av, bv) {
+		d.record(path, Update, av, bv)
+	}
+	return nil
+}
+
+// enterVisited marks v's backing pointer as visited, returning false if it
+// was already visited (indicating a cycle the caller should not recurse into).
+func (d *differ) enterVisited(v reflect.Value) bool {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Map {
+		return true
+	}
+	if v.IsNil() {
+		return true
+	}
+	key := visitedPtr{addr: v.Pointer(), typ: v.Type()}
+	if d.visited[key] {
+		return false
+	}
+	d.visited[key] = true
+	return true
+}
+
+// indirect dereferences a pointer or interface value, if any.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// valueToInterface materializes v, falling back to nil for values that
+// cannot be read (invalid or inaccessible unexported leaves).
+func valueToInterface(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if i, ok := ValueToInterface(v); ok {
+		return i
+	}
+	return nil
+}
+
+// asTextMarshaler reports whether v (or a pointer to it, if addressable)
+// implements encoding.TextMarshaler.
+func asTextMarshaler(v reflect.Value) (encoding.TextMarshaler, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	if tm, ok := v.Interface().(encoding.TextMarshaler); ok {
+		return tm, true
+	}
+	if v.CanAddr() {
+		if tm, ok := v.Addr().Interface().(encoding.TextMarshaler); ok {
+			return tm, true
+		}
+	}
+	return nil, false
+}
+
+// parseDiffTag reads the `diff` tag of a struct field, returning the path
+// segment name (the field name by default), whether "omitempty" was set, and
+// whether the field should be skipped entirely ("-").
+func parseDiffTag(field reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	name = field.Name
+	tag, ok := field.Tag.Lookup(diffTagKey)
+	if !ok {
+		return name, false, false
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] == "-" {
+		return name, false, true
+	}
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
+
+// sliceElementKeyField reports the Go field name of sliceType's element type
+// (after dereferencing one pointer level) that is tagged `diff:",key"`, the
+// field Diff uses as the element's identity when matching across slices.
+func sliceElementKeyField(sliceType reflect.Type) (string, bool) {
+	elem := sliceType.Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	for i := 0; i < elem.NumField(); i++ {
+		field := elem.Field(i)
+		tag, ok := field.Tag.Lookup(diffTagKey)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		for _, opt := range parts[1:] {
+			if opt == diffKeyOption {
+				return field.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// indexSliceByKey builds a map from the stringified value of each element's
+// key field to its index in s.
+func indexSliceByKey(s reflect.Value, keyField string) map[string]int {
+	idx := map[string]int{}
+	if !s.IsValid() {
+		return idx
+	}
+	for i := 0; i < s.Len(); i++ {
+		elem := OriginValueAndKind(s.Index(i)).OriginValue
+		if elem.Kind() != reflect.Struct {
+			continue
+		}
+		field := elem.FieldByName(keyField)
+		if !field.IsValid() {
+			continue
+		}
+		if v, ok := ValueToInterface(field); ok {
+			idx[fmt.Sprintf("%v", v)] = i
+		}
+	}
+	return idx
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: opensource@focela.com
+
+package introspection
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// PatchResult reports whether a single Change from a Patch call was applied.
+type PatchResult struct {
+	Path    []string
+	Applied bool
+	Error   error
+}
+
+// Patch applies a changeset previously computed by Diff back onto target,
+// which must be a non-nil pointer so its contents are addressable. Each
+// Change is applied independently and in order; a failure on one Change
+// (an unknown path segment, a type mismatch) is reported in its PatchResult
+// without aborting the remaining changes.
+func Patch(target interface{}, changes []Change) ([]PatchResult, error) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil, fmt.Errorf("introspection.Patch: target must be a non-nil pointer, got %T", target)
+	}
+
+	results := make([]PatchResult, 0, len(changes))
+	for _, change := range changes {
+		err := applyChange(v, change)
+		results = append(results, PatchResult{Path: change.Path, Applied: err == nil, Error: err})
+	}
+	return results, nil
+}
+
+// applyChange navigates to the container named by all but the last segment
+// of change.Path and applies change at the final segment.
+func applyChange(root reflect.Value, change Change) error {
+	if len(change.Path) == 0 {
+		return fmt.Errorf("introspection.Patch: change has an empty path")
+	}
+
+	parent, err := navigate(root, change.Path[:len(change.Path)-1])
+	if err != nil {
+		return err
+	}
+	return setAtSegment(parent, change.Path[len(change.Path)-1], change)
+}
+
+// navigate dereferences pointers/interfaces and walks path, descending into
+// structs, maps, and slices the way Diff produced their path segments.
+func navigate(v reflect.Value, path []string) (reflect.Value, error) {
+	cur := indirect(v)
+	for _, seg := range path {
+		if !cur.IsValid() {
+			return reflect.Value{}, fmt.Errorf("introspection.Patch: nil value while descending to %q", seg)
+		}
+
+		switch cur.Kind() {
+		case reflect.Struct:
+			field, ok := structFieldByDiffName(cur, seg)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("introspection.Patch: struct %s has no field matching %q", cur.Type(), seg)
+			}
+			cur = field
+
+		case reflect.Map:
+			key, err := convertMapKey(seg, cur.Type().Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			cur = cur.MapIndex(key)
+			if !cur.IsValid() {
+				return reflect.Value{}, fmt.Errorf("introspection.Patch: map has no entry %q", seg)
+			}
+
+		case reflect.Slice, reflect.Array:
+			idx, ok := sliceIndexForSegment(cur, seg)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("introspection.Patch: slice has no element matching %q", seg)
+			}
+			cur = cur.Index(idx)
+
+		default:
+			return reflect.Value{}, fmt.Errorf("introspection.Patch: cannot descend into %s at %q", cur.Kind(), seg)
+		}
+
+		cur = indirect(cur)
+	}
+	return cur, nil
+}
+
+// setAtSegment applies change to the child of parent named by seg.
+func setAtSegment(parent reflect.Value, seg string, change Change) error {
+	if !parent.IsValid() {
+		return fmt.Errorf("introspection.Patch: nil parent at %q", seg)
+	}
+
+	switch parent.Kind() {
+	case reflect.Struct:
+		field, ok := structFieldByDiffName(parent, seg)
+		if !ok {
+			return fmt.Errorf("introspection.Patch: struct %s has no field matching %q", parent.Type(), seg)
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("introspection.Patch: field %q is not settable", seg)
+		}
+		if change.Type == Delete {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		return setValue(field, change.To)
+
+	case reflect.Map:
+		return setMapEntry(parent, seg, change)
+
+	case reflect.Slice:
+		return setSliceElement(parent, seg, change)
+
+	default:
+		return fmt.Errorf("introspection.Patch: cannot patch into %s", parent.Kind())
+	}
+}
+
+// setMapEntry creates, updates, or deletes the map entry keyed by seg.
+func setMapEntry(parent reflect.Value, seg string, change Change) error {
+	key, err := convertMapKey(seg, parent.Type().Key())
+	if err != nil {
+		return err
+	}
+	if change.Type == Delete {
+		parent.SetMapIndex(key, reflect.Value{})
+		return nil
+	}
+
+	if parent.IsNil() {
+		parent.Set(reflect.MakeMap(parent.Type()))
+	}
+	elem := reflect.New(parent.Type().Elem()).Elem()
+	if err := setValue(elem, change.To); err != nil {
+		return err
+	}
+	parent.SetMapIndex(key, elem)
+	return nil
+}
+
+// setSliceElement updates, appends, or removes a slice element. Update
+// matches seg the same way navigate does (position, or a tagged key field);
+// Create appends a new element; Delete removes the matched element, shifting
+// later elements down.
+func setSliceElement(parent reflect.Value, seg string, change Change) error {
+	if !parent.CanSet() {
+		return fmt.Errorf("introspection.Patch: slice is not settable")
+	}
+
+	if change.Type == Create {
+		elem := reflect.New(parent.Type().Elem()).Elem()
+		if err := setValue(elem, change.To); err != nil {
+			return err
+		}
+		parent.Set(reflect.Append(parent, elem))
+		return nil
+	}
+
+	idx, ok := sliceIndexForSegment(parent, seg)
+	if !ok {
+		return fmt.Errorf("introspection.Patch: slice has no element matching %q", seg)
+	}
+
+	if change.Type == Delete {
+		parent.Set(reflect.AppendSlice(parent.Slice(0, idx), parent.Slice(idx+1, parent.Len())))
+		return nil
+	}
+	return setValue(parent.Index(idx), change.To)
+}
+
+// setValue assigns src (the dynamic value a Change carries) into dst,
+// converting it to dst's type when the two differ but are convertible.
+func setValue(dst reflect.Value, src interface{}) error {
+	if !dst.CanSet() {
+		return fmt.Errorf("introspection.Patch: destination of type %s is not settable", dst.Type())
+	}
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+	if sv.Type().ConvertibleTo(dst.Type()) {
+		dst.Set(sv.Convert(dst.Type()))
+		return nil
+	}
+	return fmt.Errorf("introspection.Patch: cannot assign %s to %s", sv.Type(), dst.Type())
+}
+
+// structFieldByDiffName finds the field of v (a struct) whose diff tag name,
+// or Go field name absent a tag, matches name.
+func structFieldByDiffName(v reflect.Value, name string) (reflect.Value, bool) {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		fieldName, _, skip := parseDiffTag(field)
+		if skip {
+			continue
+		}
+		if fieldName == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// sliceIndexForSegment finds the index of s's element matching seg, the way
+// Diff identified it: by a tagged key field's stringified value if present,
+// otherwise by parsing seg as a positional index.
+func sliceIndexForSegment(s reflect.Value, seg string) (int, bool) {
+	if keyField, ok := sliceElementKeyField(s.Type()); ok {
+		idx := indexSliceByKey(s, keyField)
+		i, ok := idx[seg]
+		return i, ok
+	}
+
+	var i int
+	if _, err := fmt.Sscanf(seg, "%d", &i); err != nil {
+		return 0, false
+	}
+	if i < 0 || i >= s.Len() {
+		return 0, false
+	}
+	return i, true
+}
+
+// convertMapKey converts the string path segment seg into a reflect.Value
+// assignable as a map key of keyType.
+func convertMapKey(seg string, keyType reflect.Type) (reflect.Value, error) {
+	if keyType.Kind() == reflect.String {
+		return reflect.ValueOf(seg).Convert(keyType), nil
+	}
+
+	switch keyType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if _, err := fmt.Sscanf(seg, "%d", &n); err != nil {
+			return reflect.Value{}, fmt.Errorf("introspection.Patch: key %q is not a valid %s", seg, keyType)
+		}
+		v := reflect.New(keyType).Elem()
+		v.SetInt(n)
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("introspection.Patch: unsupported map key type %s", keyType)
+	}
+}
@@ -16,10 +16,14 @@ import (
 )
 
 const (
-	maxCallerDepth = 1000            // Maximum stack depth for caller tracing.
 	stackFilterKey = "/debug/gdebug" // Filter key for stack trace filtering.
 )
 
+// MaxCallerDepth is the maximum stack depth walked for caller tracing and
+// stack capture. It was previously a hard-coded constant, which silently
+// truncated very deep stacks; callers that need more headroom can raise it.
+var MaxCallerDepth = 1000
+
 var (
 	goRootForFilter  = runtime.GOROOT() // Used for stack filtering.
 	binaryVersion    string             // Current binary version (uint64 hex).
@@ -44,57 +48,38 @@ func init() {
 
 // Caller retrieves the function name, file path, and line number of the caller.
 func Caller(skip ...int) (function string, path string, line int) {
-	return CallerWithFilter(nil, skip...)
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	// Caller wraps CallerWithFilter, adding one more frame of its own between
+	// CallerWithFilter and the frame the caller actually wants, so it skips
+	// one extra frame to land in the same place CallerWithFilter would for a
+	// direct call with the same skip.
+	return CallerWithFilter(nil, number+1)
 }
 
-// CallerWithFilter retrieves the caller's details with optional path filtering.
+// CallerWithFilter retrieves the caller's details with optional path
+// filtering. It is a thin wrapper over FramesWithFiltersDepth: a single
+// runtime.Callers call captures the PCs and runtime.CallersFrames lazily
+// symbolizes only the one frame actually needed, instead of the previous
+// per-index runtime.Caller loop (which also mis-resolved the function name,
+// passing the loop index itself to reflect.ValueOf.Pointer() instead of the
+// PC runtime.Caller returned).
 func CallerWithFilter(filters []string, skip ...int) (function string, path string, line int) {
 	number := 0
 	if len(skip) > 0 {
 		number = skip[0]
 	}
 
-	_, _, line, start := callerFromIndex(filters)
-	if start == -1 {
+	// Skip runtime.Callers, framesFromCallers, and this function's own frame,
+	// the same baseline FramesWithFilters/FramesWithFiltersDepth use.
+	frames := framesFromCallers(filters, 1, number, 3)
+	if len(frames) == 0 {
 		return "", "", -1
 	}
-
-	for i := start + number; i < maxCallerDepth; i++ {
-		_, file, line, ok := runtime.Caller(i)
-		if !ok {
-			break
-		}
-		if filterFileByFilters(file, filters) {
-			continue
-		}
-
-		fn := runtime.FuncForPC(reflect.ValueOf(i).Pointer())
-		if fn == nil {
-			function = "unknown"
-		} else {
-			function = fn.Name()
-		}
-		return function, file, line
-	}
-	return "", "", -1
-}
-
-// callerFromIndex finds the initial valid caller index, skipping filters.
-func callerFromIndex(filters []string) (pc uintptr, file string, line int, index int) {
-	for index = 0; index < maxCallerDepth; index++ {
-		pc, file, line, ok := runtime.Caller(index)
-		if !ok {
-			break
-		}
-		if filterFileByFilters(file, filters) {
-			continue
-		}
-		if index > 0 {
-			index--
-		}
-		return pc, file, line, index
-	}
-	return 0, "", -1, -1
+	f := frames[0]
+	return f.Function, f.File, f.Line
 }
 
 // filterFileByFilters filters stack trace files based on filters.
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package debug
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func callDirect() (function, path string, line int) {
+	return CallerWithFilter(nil)
+}
+
+func TestCallerWithFilter_ResolvesImmediateCaller(t *testing.T) {
+	function, path, line := callDirect()
+
+	if !strings.HasSuffix(path, "caller_test.go") {
+		t.Fatalf("path = %q, want it to end in caller_test.go", path)
+	}
+	if line <= 0 {
+		t.Fatalf("line = %d, want a positive line number", line)
+	}
+	if !strings.HasSuffix(function, "callDirect") {
+		t.Fatalf("function = %q, want it to end in callDirect", function)
+	}
+}
+
+func TestCallerWithFilter_FilteredOutReportsNoMatch(t *testing.T) {
+	function, path, line := CallerWithFilter([]string{filepath.Base("caller_test.go")})
+
+	if function != "" || path != "" || line != -1 {
+		t.Fatalf("got (%q, %q, %d), want the no-match zero value", function, path, line)
+	}
+}
+
+// TestFramesWithFiltersDepth_Bounds confirms the depth cap is honored instead
+// of walking (and symbolizing) the full stack.
+func TestFramesWithFiltersDepth_Bounds(t *testing.T) {
+	frames := FramesWithFiltersDepth(nil, 2)
+	if len(frames) > 2 {
+		t.Fatalf("len(frames) = %d, want at most 2", len(frames))
+	}
+	if len(frames) == 0 {
+		t.Fatal("expected at least one frame")
+	}
+}
+
+// TestFrameCache_ResolvesConsistently confirms a PC captured twice resolves
+// to the same Function/File/Line both times, whether served from the cache
+// (second capture) or freshly symbolized (first capture).
+func TestFrameCache_ResolvesConsistently(t *testing.T) {
+	first := Frames()
+	second := Frames()
+	if len(first) == 0 || len(second) == 0 {
+		t.Fatal("expected at least one frame from both captures")
+	}
+	if first[0].Function != second[0].Function || first[0].File != second[0].File {
+		t.Fatalf("cached frame diverged: first=%+v second=%+v", first[0], second[0])
+	}
+}
+
+// TestFrameCache_StoreThenLookup exercises frameCacheStore/frameCacheLookup
+// directly: a stored frame must be returned verbatim by a later lookup under
+// the same PC, and an unseen PC must report a miss.
+func TestFrameCache_StoreThenLookup(t *testing.T) {
+	const pc = uintptr(0xdeadbeef)
+	if _, ok := frameCacheLookup(pc); ok {
+		t.Fatal("expected a miss for a PC never stored")
+	}
+
+	want := Frame{PC: pc, Function: "test.Fn", File: "test.go", Line: 42}
+	frameCacheStore(want)
+
+	got, ok := frameCacheLookup(pc)
+	if !ok {
+		t.Fatal("expected a hit after frameCacheStore")
+	}
+	if got != want {
+		t.Fatalf("frameCacheLookup = %+v, want %+v", got, want)
+	}
+}
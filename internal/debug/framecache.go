@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package debug provides utilities for enabling and managing debug mode in the application.
+package debug
+
+import (
+	"container/list"
+	"sync"
+)
+
+// frameCacheMaxEntries bounds the frame cache so a process that walks stacks
+// through an unbounded number of distinct call sites (e.g. generated code,
+// plugins) can't grow it without limit.
+const frameCacheMaxEntries = 4096
+
+// frameCacheEntry is one list element of the cache, pairing the PC it was
+// stored under with the Frame resolved for it, so the LRU eviction in
+// frameCacheStore can find the right map key to delete.
+type frameCacheEntry struct {
+	pc    uintptr
+	frame Frame
+}
+
+var (
+	frameCacheMu    sync.RWMutex
+	frameCacheOrder = list.New()
+	frameCacheIndex = make(map[uintptr]*list.Element)
+)
+
+// frameCacheLookup returns the cached Function/File/Line/Package/Entry for
+// pc, if FramesWithFilters has resolved it before, and marks it
+// most-recently-used.
+func frameCacheLookup(pc uintptr) (Frame, bool) {
+	frameCacheMu.Lock()
+	defer frameCacheMu.Unlock()
+
+	elem, ok := frameCacheIndex[pc]
+	if !ok {
+		return Frame{}, false
+	}
+	frameCacheOrder.MoveToFront(elem)
+	return elem.Value.(*frameCacheEntry).frame, true
+}
+
+// frameCacheStore records frame under frame.PC, evicting the
+// least-recently-used entry if the cache is at capacity.
+func frameCacheStore(frame Frame) {
+	frameCacheMu.Lock()
+	defer frameCacheMu.Unlock()
+
+	if elem, ok := frameCacheIndex[frame.PC]; ok {
+		elem.Value.(*frameCacheEntry).frame = frame
+		frameCacheOrder.MoveToFront(elem)
+		return
+	}
+
+	elem := frameCacheOrder.PushFront(&frameCacheEntry{pc: frame.PC, frame: frame})
+	frameCacheIndex[frame.PC] = elem
+
+	if frameCacheOrder.Len() > frameCacheMaxEntries {
+		oldest := frameCacheOrder.Back()
+		if oldest != nil {
+			frameCacheOrder.Remove(oldest)
+			delete(frameCacheIndex, oldest.Value.(*frameCacheEntry).pc)
+		}
+	}
+}
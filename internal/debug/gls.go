@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package debug
+
+import "sync"
+
+// GLS is goroutine-local storage keyed by the calling goroutine's ID (see
+// GoroutineId), so intlog and other internal subsystems can propagate
+// request-scoped debug metadata through private helpers without threading a
+// context.Context into every one of them.
+//
+// Goroutine IDs get reused once a goroutine exits, so a value left behind by
+// a goroutine that never called Cleanup can silently leak into a later,
+// unrelated goroutine that happens to be assigned the same ID. Callers MUST
+// call Cleanup in a defer, right after populating the store:
+//
+//	debug.GLS.Set("requestID", id)
+//	defer debug.GLS.Cleanup()
+var GLS = &glsStore{}
+
+// glsEntryKey scopes a user key to the goroutine that set it.
+type glsEntryKey struct {
+	gid int
+	key interface{}
+}
+
+// glsStore is the concrete type behind GLS; sync.Map is a reasonable fit
+// since entries are set and cleaned up far more often than they're iterated.
+type glsStore struct {
+	m sync.Map
+}
+
+// Set stores val under key, scoped to the calling goroutine.
+func (s *glsStore) Set(key, val interface{}) {
+	s.m.Store(glsEntryKey{gid: GoroutineId(), key: key}, val)
+}
+
+// Get returns the value stored under key for the calling goroutine, if any.
+func (s *glsStore) Get(key interface{}) (interface{}, bool) {
+	return s.m.Load(glsEntryKey{gid: GoroutineId(), key: key})
+}
+
+// Cleanup removes every entry set by the calling goroutine. Call it in a
+// defer before the goroutine returns; see the GLS doc comment for why.
+func (s *glsStore) Cleanup() {
+	gid := GoroutineId()
+	s.m.Range(func(k, _ interface{}) bool {
+		if entry, ok := k.(glsEntryKey); ok && entry.gid == gid {
+			s.m.Delete(k)
+		}
+		return true
+	})
+}
@@ -14,14 +14,30 @@ import (
 // gridRegex extracts the goroutine ID from stack trace information.
 var gridRegex = regexp.MustCompile(`^\w+\s+(\d+)\s+`)
 
-// GoroutineId retrieves the current goroutine ID from stack information.
-//
-// Warning: This function uses runtime.Stack, which is not efficient. Avoid using
-// it frequently in performance-critical code. It is mainly intended for debugging purposes.
+// fastGoroutineID is populated by an arch-specific file (see grid_fast.go)
+// with a g-pointer read that avoids runtime.Stack entirely. It stays nil on
+// architectures, or Go versions, where that isn't wired up or didn't
+// self-calibrate successfully, and GoroutineId falls back to goroutineIDSlow.
+var fastGoroutineID func() (int, bool)
+
+// GoroutineId retrieves the current goroutine ID.
 //
-// Returns:
-// - int: The ID of the current goroutine.
+// On amd64/arm64 this reads the id directly off the running goroutine's g
+// struct; everywhere else, and if that fast path ever fails its startup
+// self-check, it falls back to parsing runtime.Stack's header line, which is
+// correct on every platform but allocates and scans text on every call.
 func GoroutineId() int {
+	if fastGoroutineID != nil {
+		if id, ok := fastGoroutineID(); ok {
+			return id
+		}
+	}
+	return goroutineIDSlow()
+}
+
+// goroutineIDSlow is the original runtime.Stack-based implementation, kept
+// as the fallback for platforms the fast path doesn't cover.
+func goroutineIDSlow() int {
 	// Allocate a small buffer for the stack trace.
 	buf := make([]byte, 64) // Increased buffer size for safety.
 
@@ -0,0 +1,151 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+//go:build (amd64 || arm64) && gc && !purego
+
+package debug
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// getg returns the current goroutine's runtime.g pointer. It's implemented
+// in grid_fast_GOARCH.s, which reads it straight out of the TLS slot (amd64)
+// or the dedicated g register (arm64) the runtime itself uses - no
+// go:linkname into runtime is possible here because getg is a compiler
+// intrinsic with no linkable body outside package runtime.
+func getg() unsafe.Pointer
+
+// maxGoidScan bounds how far into the g struct calibrateGoidOffset will
+// look for the goid field. It's comfortably larger than goid's offset has
+// been across recent Go versions while staying well inside the struct, so
+// the scan never reads outside the allocation backing g.
+const maxGoidScan = 256
+
+// goidOffset is the byte offset of g.goid within runtime.g, discovered once
+// by calibrateGoidOffset. A value of -1 means calibration failed (or hasn't
+// run yet) and the fast path must not be used.
+var goidOffset int64 = -1
+
+var calibrateOnce sync.Once
+
+func init() {
+	fastGoroutineID = readFastGoroutineID
+}
+
+// readFastGoroutineID is fastGoroutineID's implementation on supported
+// architectures: calibrate the goid offset once, then every call after that
+// is a single pointer dereference.
+func readFastGoroutineID() (int, bool) {
+	calibrateOnce.Do(calibrateGoidOffset)
+
+	offset := atomic.LoadInt64(&goidOffset)
+	if offset < 0 {
+		return 0, false
+	}
+	g := getg()
+	if g == nil {
+		return 0, false
+	}
+	id := *(*int64)(unsafe.Pointer(uintptr(g) + uintptr(offset)))
+	return int(id), true
+}
+
+// calibrateGoidOffset finds g.goid's byte offset by comparing candidate
+// 8-byte words of a g against the id goroutineIDSlow reports for that same
+// goroutine, instead of hard-coding an offset that drifts between Go
+// versions. Runtime.g's layout isn't part of the Go compatibility promise,
+// so this is inherently best-effort: if calibration can't settle on a single
+// offset (a future runtime moved or resized the field), the fast path
+// disables itself and GoroutineId falls back to the slow path for the life
+// of the process.
+//
+// A single sample isn't enough: runtime.g has several small-integer,
+// often-zero fields near the front (status, a wait reason, ...), and early
+// in a process goroutine IDs are themselves small, so a coincidental match
+// on an unrelated field is a real risk. Collecting candidates from two
+// goroutines with distinct, concurrently-live IDs and keeping only offsets
+// that agree on both rules that out.
+func calibrateGoidOffset() {
+	g1 := getg()
+	if g1 == nil {
+		return
+	}
+	want1 := int64(goroutineIDSlow())
+	if want1 <= 0 {
+		return
+	}
+	candidates1 := matchingOffsets(g1, want1)
+	if len(candidates1) == 0 {
+		return
+	}
+
+	agreed, ok := crossCheckAgainstOtherGoroutine(candidates1, want1)
+	if ok {
+		atomic.StoreInt64(&goidOffset, agreed)
+	}
+	// No offset agreed across both samples; leave goidOffset at -1 so the
+	// caller falls back.
+}
+
+// crossCheckAgainstOtherGoroutine spawns a second goroutine and keeps it
+// parked (blocked on a channel receive, not exited) while reading its g
+// pointer and slow-path goroutine ID: once a goroutine returns, the runtime
+// is free to recycle its g for something else, so the candidate offsets
+// have to be read while it's still alive. It returns the single offset
+// in candidates1 that also matches the second goroutine's id, or false if
+// zero or more than one do.
+func crossCheckAgainstOtherGoroutine(candidates1 []int64, want1 int64) (int64, bool) {
+	ready := make(chan struct{})
+	release := make(chan struct{})
+	var g2 unsafe.Pointer
+	var want2 int64
+	go func() {
+		g2 = getg()
+		want2 = int64(goroutineIDSlow())
+		close(ready)
+		<-release
+	}()
+	<-ready
+	defer close(release)
+
+	if g2 == nil || want2 <= 0 || want2 == want1 {
+		return 0, false
+	}
+	candidates2 := matchingOffsets(g2, want2)
+
+	agreed := int64(-1)
+	for _, off := range candidates1 {
+		if slices.Contains(candidates2, off) {
+			if agreed >= 0 {
+				// More than one offset survived both samples; the field
+				// can't be identified unambiguously. Bail rather than
+				// guessing.
+				return 0, false
+			}
+			agreed = off
+		}
+	}
+	if agreed < 0 {
+		return 0, false
+	}
+	return agreed, true
+}
+
+// matchingOffsets scans the first maxGoidScan bytes of g for every 8-byte
+// word equal to want, returning all of them (not just the first) so the
+// caller can intersect against a second goroutine's samples.
+func matchingOffsets(g unsafe.Pointer, want int64) []int64 {
+	var offsets []int64
+	for off := uintptr(0); off < maxGoidScan; off += 8 {
+		candidate := *(*int64)(unsafe.Pointer(uintptr(g) + off))
+		if candidate == want {
+			offsets = append(offsets, int64(off))
+		}
+	}
+	return offsets
+}
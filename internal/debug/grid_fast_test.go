@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+//go:build (amd64 || arm64) && gc && !purego
+
+package debug
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// TestMatchingOffsets covers the scan that calibrateGoidOffset builds its
+// candidates from: every 8-byte word equal to want must be reported, not
+// just the first.
+func TestMatchingOffsets(t *testing.T) {
+	g := struct{ a, b, c, d int64 }{a: 1, b: 42, c: 42, d: 2}
+
+	offsets := matchingOffsets(unsafe.Pointer(&g), 42)
+	if len(offsets) != 2 || offsets[0] != 8 || offsets[1] != 16 {
+		t.Fatalf("matchingOffsets = %v, want [8 16]", offsets)
+	}
+}
+
+// TestMatchingOffsets_NoMatch confirms an absent value reports no candidates
+// rather than a false positive.
+func TestMatchingOffsets_NoMatch(t *testing.T) {
+	g := struct{ a, b int64 }{a: 1, b: 2}
+
+	offsets := matchingOffsets(unsafe.Pointer(&g), 999)
+	if len(offsets) != 0 {
+		t.Fatalf("matchingOffsets = %v, want none", offsets)
+	}
+}
+
+// TestCrossCheckAgainstOtherGoroutine_Disagreement confirms that when want1
+// isn't a valid id, calibration reports failure instead of guessing.
+func TestCrossCheckAgainstOtherGoroutine_Disagreement(t *testing.T) {
+	candidates1 := []int64{0, 8, 16}
+	_, ok := crossCheckAgainstOtherGoroutine(candidates1, -1)
+	if ok {
+		t.Fatal("crossCheckAgainstOtherGoroutine should fail when want1 is not a valid id")
+	}
+}
+
+// TestReadFastGoroutineID_AgreesWithSlowPath calibrates the real fast path
+// against the real runtime.g and checks it reports the same id
+// goroutineIDSlow does, end to end, instead of exercising the scan logic in
+// isolation.
+func TestReadFastGoroutineID_AgreesWithSlowPath(t *testing.T) {
+	want := goroutineIDSlow()
+
+	got, ok := readFastGoroutineID()
+	if !ok {
+		t.Skip("fast goid calibration did not settle on an offset on this runtime; GoroutineId falls back to the slow path")
+	}
+	if got != want {
+		t.Fatalf("readFastGoroutineID = %d, want %d (goroutineIDSlow)", got, want)
+	}
+}
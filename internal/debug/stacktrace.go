@@ -6,11 +6,43 @@
 package debug
 
 import (
-	"bytes"
+	"encoding/json"
 	"fmt"
 	"runtime"
+	"strings"
 )
 
+// Frame is a single entry of a captured call stack, built from
+// runtime.CallersFrames so inlined frames are reported correctly (unlike a
+// per-index runtime.Caller loop, which skips over them).
+type Frame struct {
+	Index    int
+	PC       uintptr
+	Function string
+	File     string
+	Line     int
+	Package  string
+	Entry    uintptr
+}
+
+// FrameFormatter renders a slice of Frame as text, e.g. for PrintStack.
+// Assign to DefaultFrameFormatter to customize the layout used by Stack.
+type FrameFormatter func(frames []Frame) string
+
+// DefaultFrameFormatter reproduces the historical Stack/StackWithFilters
+// text layout: one numbered "function\n    file:line" entry per frame.
+var DefaultFrameFormatter FrameFormatter = func(frames []Frame) string {
+	var b strings.Builder
+	for _, f := range frames {
+		space := "  "
+		if f.Index > 9 {
+			space = " "
+		}
+		fmt.Fprintf(&b, "%d.%s%s\n    %s:%d\n", f.Index, space, f.Function, f.File, f.Line)
+	}
+	return b.String()
+}
+
 // PrintStack prints the current goroutine stack trace to standard error.
 // Optional `skip` parameter allows skipping specific stack frames.
 func PrintStack(skip ...int) {
@@ -30,50 +62,126 @@ func StackWithFilter(filters []string, skip ...int) string {
 	return StackWithFilters(filters, skip...)
 }
 
-// StackWithFilters returns a filtered stack trace of the current goroutine.
+// StackWithFilters returns a filtered stack trace of the current goroutine,
+// rendered with DefaultFrameFormatter.
 // Filters are applied to remove unwanted stack frames.
 // Optional `skip` parameter allows skipping specific stack frames.
 func StackWithFilters(filters []string, skip ...int) string {
-	skipFrames := 0
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	return DefaultFrameFormatter(FramesWithFilters(filters, number+1))
+}
+
+// StackJSON returns the same frames as StackWithFilters, JSON-encoded.
+func StackJSON(skip ...int) []byte {
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	return FramesJSON(nil, number+1)
+}
+
+// Frames returns the filtered call stack of the calling goroutine as a slice
+// of Frame.
+func Frames(skip ...int) []Frame {
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	// Skip runtime.Callers, framesFromCallers, and this function's own frame.
+	return framesFromCallers(nil, MaxCallerDepth, number, 3)
+}
+
+// FramesWithFilters returns the filtered call stack of the calling goroutine,
+// built from runtime.CallersFrames so inlined calls are represented
+// faithfully (a per-index runtime.Caller loop misclassifies them).
+func FramesWithFilters(filters []string, skip ...int) []Frame {
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	// Skip runtime.Callers, framesFromCallers, and this function's own frame.
+	return framesFromCallers(filters, MaxCallerDepth, number, 3)
+}
+
+// FramesWithFiltersDepth is FramesWithFilters bounded to at most depth
+// frames, so a caller that only needs the top few frames (the common case
+// for an error's capture point) doesn't pay to walk and symbolize the rest
+// of a deep stack.
+func FramesWithFiltersDepth(filters []string, depth int, skip ...int) []Frame {
+	number := 0
 	if len(skip) > 0 {
-		skipFrames = skip[0]
+		number = skip[0]
 	}
+	// Skip runtime.Callers, framesFromCallers, and this function's own frame.
+	return framesFromCallers(filters, depth, number, 3)
+}
+
+// framesFromCallers is the shared implementation behind FramesWithFilters
+// and FramesWithFiltersDepth: it captures PCs with a single runtime.Callers
+// call, symbolizes them lazily via runtime.CallersFrames, and stops once
+// depth frames have been collected. callerSkip is the number of frames
+// runtime.Callers itself should skip to land on the caller of the exported
+// wrapper function, so both wrappers see identical skip/filter semantics.
+//
+// Each resolved frame's Function/File/Line/Package is cached by PC (see
+// framecache.go): a call site that's captured repeatedly, as happens every
+// time errors.NewCode/WrapCode runs on a hot path, pays the string-parsing
+// cost of getPackageFromCallerFunction once instead of on every capture.
+func framesFromCallers(filters []string, depth, skip, callerSkip int) []Frame {
+	pcs := make([]uintptr, MaxCallerDepth)
+	n := runtime.Callers(callerSkip, pcs)
+	callersFrames := runtime.CallersFrames(pcs[:n])
 
 	var (
-		buffer = bytes.NewBuffer(nil)
-		index  = 1
-		space  = "  "
-		ok     = true
-		pc     uintptr
-		file   string
-		line   int
+		frames  []Frame
+		index   = 1
+		skipped = 0
 	)
+	for {
+		frame, more := callersFrames.Next()
 
-	// Bắt đầu từ caller index sau khi áp dụng bộ lọc
-	_, _, _, start := callerFromIndex(filters)
-
-	for i := start + skipFrames; i < maxCallerDepth; i++ {
-		pc, file, line, ok = runtime.Caller(i)
-		if !ok {
-			break
+		if filterFileByFilters(frame.File, filters) {
+			if !more {
+				break
+			}
+			continue
 		}
-
-		if filterFileByFilters(file, filters) {
+		if skipped < skip {
+			skipped++
+			if !more {
+				break
+			}
 			continue
 		}
 
-		funcName := "unknown"
-		if fn := runtime.FuncForPC(pc); fn != nil {
-			funcName = fn.Name()
+		resolved, ok := frameCacheLookup(frame.PC)
+		if !ok {
+			resolved = Frame{
+				PC:       frame.PC,
+				Function: frame.Function,
+				File:     frame.File,
+				Line:     frame.Line,
+				Package:  getPackageFromCallerFunction(frame.Function),
+				Entry:    frame.Entry,
+			}
+			frameCacheStore(resolved)
 		}
+		resolved.Index = index
+		frames = append(frames, resolved)
+		index++
 
-		if index > 9 {
-			space = " "
+		if len(frames) >= depth || !more {
+			break
 		}
-
-		buffer.WriteString(fmt.Sprintf("%d.%s%s\n    %s:%d\n", index, space, funcName, file, line))
-		index++
 	}
+	return frames
+}
 
-	return buffer.String()
+// FramesJSON returns FramesWithFilters(filters, skip...), JSON-encoded.
+func FramesJSON(filters []string, skip ...int) []byte {
+	data, _ := json.Marshal(FramesWithFilters(filters, skip...))
+	return data
 }
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 )
 
 // Constants for stack and filter configuration.
@@ -29,6 +30,28 @@ var (
 	selfPath         string
 )
 
+// registeredStackFilters holds additional path substrings registered via
+// RegisterStackFilter, checked alongside stackFilterKey. This lets
+// instrumentation wrappers (tracing, logging middleware, etc.) hide their own
+// frames from caller/stack output without forking the package.
+var (
+	registeredStackFiltersMu sync.RWMutex
+	registeredStackFilters   []string
+)
+
+// RegisterStackFilter adds substr to the set of path substrings excluded from
+// caller and stack output, in addition to the package's own frames. Intended
+// for instrumentation wrappers (e.g. tracing helpers) so they don't show up
+// as the "caller" of the code they wrap.
+func RegisterStackFilter(substr string) {
+	if substr == "" {
+		return
+	}
+	registeredStackFiltersMu.Lock()
+	defer registeredStackFiltersMu.Unlock()
+	registeredStackFilters = append(registeredStackFilters, substr)
+}
+
 // Initialize global variables.
 func init() {
 	if goRootForFilter != "" {
@@ -59,7 +82,7 @@ func CallerWithFilter(filters []string, skip ...int) (function string, path stri
 	pc, file, line, start := callerFromIndex(filters)
 	if start != -1 {
 		var ok bool
-		for i := start + number; i < maxCallerDepth; i++ {
+		for i := start + number; i < MaxDepth; i++ {
 			if i != start {
 				pc, file, line, ok = runtime.Caller(i)
 				if !ok {
@@ -83,7 +106,7 @@ func CallerWithFilter(filters []string, skip ...int) (function string, path stri
 // callerFromIndex determines the starting index for the caller stack trace.
 func callerFromIndex(filters []string) (pc uintptr, file string, line int, index int) {
 	var ok bool
-	for index = 0; index < maxCallerDepth; index++ {
+	for index = 0; index < MaxDepth; index++ {
 		if pc, file, line, ok = runtime.Caller(index); ok {
 			if filterFileByFilters(file, filters) {
 				continue
@@ -109,6 +132,14 @@ func filterFileByFilters(file string, filters []string) (filtered bool) {
 		}
 	}
 
+	registeredStackFiltersMu.RLock()
+	defer registeredStackFiltersMu.RUnlock()
+	for _, filter := range registeredStackFilters {
+		if strings.Contains(file, filter) {
+			return true
+		}
+	}
+
 	if goRootForFilter != "" && strings.HasPrefix(file, goRootForFilter) {
 		fileSeparator := file[len(goRootForFilter)]
 		if fileSeparator == filepath.Separator || fileSeparator == '\\' || fileSeparator == '/' {
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package debugger provides utilities for debugging, including logging and tracking application state.
+package debugger
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// MaxDepth is the maximum number of stack frames walked when collecting a
+// call stack. It defaults to maxCallerDepth and may be lowered by callers
+// that only need shallow traces.
+var MaxDepth = maxCallerDepth
+
+// Frame is a machine-parseable representation of a single call stack entry.
+type Frame struct {
+	Function  string // Fully qualified function name.
+	Package   string // Package path the function belongs to.
+	File      string // Source file path.
+	Line      int    // Line number within File.
+	IsRuntime bool   // Whether the frame belongs to the Go runtime package.
+	IsGoRoot  bool   // Whether File lives under GOROOT.
+}
+
+// Formatter renders a slice of Frame as a string, e.g. for text, JSON, or
+// logfmt log output. SetFormatter overrides the default used by String.
+type Formatter func([]Frame) string
+
+// defaultFormatter renders frames the same way StackWithFilters does.
+var defaultFormatter Formatter = func(frames []Frame) string {
+	var b strings.Builder
+	for i, f := range frames {
+		space := "  "
+		if i+1 > 9 {
+			space = " "
+		}
+		b.WriteString(fmt.Sprintf("%d.%s%s\n    %s:%d\n", i+1, space, f.Function, f.File, f.Line))
+	}
+	return b.String()
+}
+
+// SetFormatter overrides the formatter used by FramesString to render a
+// []Frame, letting log adapters render text, JSON, or logfmt output.
+func SetFormatter(formatter Formatter) {
+	if formatter != nil {
+		defaultFormatter = formatter
+	}
+}
+
+// Frames returns the filtered call stack of the calling goroutine as a slice
+// of Frame, applying the same caller filters as CallerWithFilter.
+func Frames(skip ...int) []Frame {
+	return FramesWithFilter(nil, skip...)
+}
+
+// FramesWithFilter returns the filtered call stack, applying filters in
+// addition to the package's own stack filters.
+func FramesWithFilter(filters []string, skip ...int) []Frame {
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+
+	var (
+		frames                []Frame
+		ok                    = true
+		pc, file, line, start = callerFromIndex(filters)
+	)
+
+	for i := start + number; i < MaxDepth; i++ {
+		if i != start {
+			pc, file, line, ok = runtime.Caller(i)
+		}
+		if !ok {
+			break
+		}
+		if filterFileByFilters(file, filters) {
+			continue
+		}
+
+		function := "unknown"
+		pkg := ""
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			function = fn.Name()
+			pkg = getPackageFromCallerFunction(function)
+		}
+
+		frames = append(frames, Frame{
+			Function:  function,
+			Package:   pkg,
+			File:      file,
+			Line:      line,
+			IsRuntime: strings.HasPrefix(function, "runtime."),
+			IsGoRoot:  goRootForFilter != "" && strings.HasPrefix(file, goRootForFilter),
+		})
+	}
+	return frames
+}
+
+// StackJSON returns the filtered call stack of the calling goroutine encoded
+// as a JSON array of Frame, for consumption by observability pipelines.
+func StackJSON(skip ...int) []byte {
+	number := 0
+	if len(skip) > 0 {
+		number = skip[0]
+	}
+	data, _ := json.Marshal(Frames(number + 1))
+	return data
+}
+
+// FramesString renders frames using the formatter set via SetFormatter (text
+// by default).
+func FramesString(frames []Frame) string {
+	return defaultFormatter(frames)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package debugger provides utilities for debugging, including logging and tracking application state.
+package debugger
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry semantic convention attribute keys for source code location,
+// see https://opentelemetry.io/docs/specs/semconv/general/attributes/#source-code-attributes.
+const (
+	attrCodeFunction  = "code.function"
+	attrCodeNamespace = "code.namespace"
+	attrCodeFilepath  = "code.filepath"
+	attrCodeLineno    = "code.lineno"
+)
+
+func init() {
+	// The span helpers below always walk through this file, so it must never
+	// be mistaken for application caller code.
+	RegisterStackFilter("/debugger/otel")
+}
+
+// AnnotateSpan sets code.function, code.filepath, code.lineno, and
+// code.namespace attributes on the span currently stored in ctx, derived from
+// CallerWithFilter. It is a no-op if ctx carries no recording span.
+func AnnotateSpan(ctx context.Context, skip ...int) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(callerAttributes(skip...)...)
+}
+
+// StartSpan opens a span named name via tracer, pre-populated with the
+// current caller's source-code attributes, and returns the derived context
+// along with the span so the caller can End() it.
+func StartSpan(ctx context.Context, tracer trace.Tracer, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, name, opts...)
+	span.SetAttributes(callerAttributes(1)...)
+	return ctx, span
+}
+
+// callerAttributes resolves the caller (applying the registered stack
+// filters) and renders it as OpenTelemetry source-code attributes.
+func callerAttributes(skip ...int) []attribute.KeyValue {
+	function, path, line := Caller(skip...)
+	if path == "" {
+		return nil
+	}
+
+	namespace := function
+	if idx := strings.LastIndexByte(function, '.'); idx >= 0 {
+		namespace = function[:idx]
+	}
+
+	return []attribute.KeyValue{
+		attribute.String(attrCodeFunction, function),
+		attribute.String(attrCodeNamespace, namespace),
+		attribute.String(attrCodeFilepath, path),
+		attribute.Int(attrCodeLineno, line),
+	}
+}
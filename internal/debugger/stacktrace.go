@@ -57,7 +57,7 @@ func StackWithFilters(filters []string, skip ...int) string {
 		pc, file, line, start = callerFromIndex(filters)
 	)
 
-	for i := start + number; i < maxCallerDepth; i++ {
+	for i := start + number; i < MaxDepth; i++ {
 		if i != start {
 			pc, file, line, ok = runtime.Caller(i)
 		}
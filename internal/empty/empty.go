@@ -30,6 +30,14 @@ type (
 		Date() (year int, month time.Month, day int)
 		IsZero() bool
 	}
+
+	// Zeroer lets a type declare its own emptiness, overriding the default
+	// reflection-based check below. Types such as sql.NullString,
+	// decimal.Decimal, or uuid.UUID can implement it so IsEmpty respects
+	// their own notion of "empty" instead of walking their fields.
+	Zeroer interface {
+		IsZero() bool
+	}
 )
 
 // IsEmpty checks if the given `value` is empty.
@@ -92,34 +100,17 @@ func IsEmpty(value interface{}, traceSource ...bool) bool {
 			rv = v
 		} else {
 			rv = reflect.ValueOf(value)
-			if IsNil(rv) {
-				return true
-			}
+		}
+		if IsNil(rv) {
+			return true
+		}
 
-			// Check for common interfaces.
-			if f, ok := value.(TimeProvider); ok {
-				if f == (*time.Time)(nil) {
-					return true
-				}
-				return f.IsZero()
-			}
-			if f, ok := value.(Stringer); ok {
-				if f == nil {
-					return true
-				}
-				return f.String() == ""
-			}
-			if f, ok := value.(InterfacesProvider); ok {
-				if f == nil {
-					return true
-				}
-				return len(f.Interfaces()) == 0
-			}
-			if f, ok := value.(MapConverter); ok {
-				if f == nil {
-					return true
-				}
-				return len(f.MapStrAny()) == 0
+		// Check for common interfaces. This runs for both a plain value and
+		// one already wrapped in reflect.Value, so IsEmpty(myTime) and
+		// IsEmpty(reflect.ValueOf(myTime)) agree.
+		if rv.CanInterface() {
+			if empty, ok := checkEmptyInterfaces(rv.Interface()); ok {
+				return empty
 			}
 		}
 
@@ -190,6 +181,68 @@ func IsEmpty(value interface{}, traceSource ...bool) bool {
 	}
 }
 
+// checkEmptyInterfaces tests v against the interfaces IsEmpty treats as
+// self-describing their own emptiness. ok is false when v implements none of
+// them, so the caller falls through to the generic reflect.Kind switch.
+// Zeroer is checked last so it only catches types that don't already have a
+// narrower check above it (time.Time, for instance, also satisfies Zeroer,
+// but TimeProvider's typed nil check must run first to avoid a nil-pointer
+// panic on a nil *time.Time).
+func checkEmptyInterfaces(v interface{}) (empty bool, ok bool) {
+	if f, has := v.(TimeProvider); has {
+		if f == (*time.Time)(nil) {
+			return true, true
+		}
+		return f.IsZero(), true
+	}
+	if f, has := v.(Stringer); has {
+		if f == nil {
+			return true, true
+		}
+		return f.String() == "", true
+	}
+	if f, has := v.(InterfacesProvider); has {
+		if f == nil {
+			return true, true
+		}
+		return len(f.Interfaces()) == 0, true
+	}
+	if f, has := v.(MapConverter); has {
+		if f == nil {
+			return true, true
+		}
+		return len(f.MapStrAny()) == 0, true
+	}
+	if f, has := v.(Zeroer); has {
+		if f == nil {
+			return true, true
+		}
+		return f.IsZero(), true
+	}
+	return false, false
+}
+
+// IsZero reports whether v equals its type's zero value. Unlike IsEmpty, it
+// takes T as a compile-time type parameter, so comparable types are checked
+// with a direct `==` instead of boxing into interface{} and going through
+// reflection.
+func IsZero[T comparable](v T) bool {
+	var zero T
+	return v == zero
+}
+
+// IsEmptyOf is the generic counterpart to IsEmpty. When T is comparable at
+// the reflect level it compares v against its zero value directly via
+// reflect.Value.Equal, skipping IsEmpty's type switch and interface checks;
+// otherwise (slices, maps, funcs, and anything embedding them) it falls back
+// to IsEmpty, which knows how to treat those as empty when merely unset.
+func IsEmptyOf[T any](v T) bool {
+	if rv := reflect.ValueOf(v); rv.IsValid() && rv.Comparable() {
+		return rv.Equal(reflect.Zero(rv.Type()))
+	}
+	return IsEmpty(v)
+}
+
 // IsNil checks whether a given `value` is nil, especially for interface{} types.
 // If `traceSource` is true, it traces through pointer references.
 func IsNil(value interface{}, traceSource ...bool) bool {
@@ -11,12 +11,22 @@
  */
 
 // Package reflection provides utility functions for working with reflection in Go.
+//
+// This package is the canonical home for OriginValueAndKind, OriginTypeAndKind,
+// and ValueToInterface. The near-identical copies in internal/kernel forward
+// here and are deprecated.
 package reflection
 
 import (
 	"reflect"
 )
 
+// MaxDepth bounds how many pointer indirections OriginValueAndKind and
+// OriginTypeAndKind will chase. It guards against a runaway **...**T type
+// (or, for values, a self-referential pointer chain) walking the stack
+// unbounded.
+var MaxDepth = 1000
+
 // OriginValueAndKindOutput represents the result of retrieving the origin value and kind of an input.
 type OriginValueAndKindOutput struct {
 	InputValue  reflect.Value
@@ -35,18 +45,20 @@ type OriginTypeAndKindOutput struct {
 
 // OriginValueAndKind retrieves the original value and kind of the given input using reflection.
 // It handles pointers and resolves them to their base value.
+//
+// A zero value of a non-nil pointer is still dereferenceable, so the pointer
+// chain is only stopped by IsNil, never by IsZero.
 func OriginValueAndKind(value interface{}) (out OriginValueAndKindOutput) {
-	if v, ok := value.(reflect.Value); ok {
-		out.InputValue = v
-	} else {
-		out.InputValue = reflect.ValueOf(value)
-	}
+	out.InputValue = unwrapValue(value)
 	out.InputKind = out.InputValue.Kind()
 	out.OriginValue = out.InputValue
 	out.OriginKind = out.InputKind
 
-	// Resolve pointer chain to base value
-	for out.OriginKind == reflect.Ptr && out.OriginValue.IsValid() && !out.OriginValue.IsZero() {
+	// Resolve pointer chain to base value.
+	for depth := 0; out.OriginKind == reflect.Ptr && depth < MaxDepth; depth++ {
+		if out.OriginValue.IsNil() {
+			break
+		}
 		out.OriginValue = out.OriginValue.Elem()
 		out.OriginKind = out.OriginValue.Kind()
 	}
@@ -73,14 +85,35 @@ func OriginTypeAndKind(value interface{}) (out OriginTypeAndKindOutput) {
 	out.OriginType = out.InputType
 	out.OriginKind = out.InputKind
 
-	// Resolve pointer chain to base type
-	for out.OriginKind == reflect.Ptr && out.OriginType != nil {
+	// Resolve pointer chain to base type.
+	for depth := 0; out.OriginKind == reflect.Ptr && out.OriginType != nil && depth < MaxDepth; depth++ {
 		out.OriginType = out.OriginType.Elem()
 		out.OriginKind = out.OriginType.Kind()
 	}
 	return
 }
 
+// unwrapValue resolves `value` to a reflect.Value, fully unwrapping the case
+// where value is itself a reflect.Value wrapping another reflect.Value (as
+// can happen when a caller forwards a value obtained from v.Interface() on an
+// already-reflected input).
+func unwrapValue(value interface{}) reflect.Value {
+	v, ok := value.(reflect.Value)
+	if !ok {
+		return reflect.ValueOf(value)
+	}
+	for {
+		if !v.CanInterface() {
+			return v
+		}
+		inner, ok := v.Interface().(reflect.Value)
+		if !ok {
+			return v
+		}
+		v = inner
+	}
+}
+
 // It supports primitive types, pointers, interfaces, and recursively resolves their values.
 func ValueToInterface(v reflect.Value) (interface{}, bool) {
 	if !v.IsValid() {
@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package reflection
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestOriginValueAndKind_ZeroNonNilPointer pins the behavioral delta this
+// package resolved in favor of: a pointer to a zero value is still
+// dereferenceable, so the chain must stop on IsNil, never on IsZero.
+func TestOriginValueAndKind_ZeroNonNilPointer(t *testing.T) {
+	var zero int
+	p := &zero
+
+	out := OriginValueAndKind(p)
+	if out.OriginKind != reflect.Int {
+		t.Fatalf("OriginKind = %v, want Int (zero-valued but non-nil pointer must be dereferenced)", out.OriginKind)
+	}
+	if out.OriginValue.Int() != 0 {
+		t.Fatalf("OriginValue = %v, want 0", out.OriginValue.Int())
+	}
+}
+
+func TestOriginValueAndKind_NilPointerStops(t *testing.T) {
+	var p *int
+
+	out := OriginValueAndKind(p)
+	if out.OriginKind != reflect.Ptr {
+		t.Fatalf("OriginKind = %v, want Ptr (nil pointer must stop the chain)", out.OriginKind)
+	}
+}
+
+func TestOriginValueAndKind_MaxDepthGuard(t *testing.T) {
+	orig := MaxDepth
+	MaxDepth = 3
+	defer func() { MaxDepth = orig }()
+
+	// Build a pointer chain deeper than MaxDepth and confirm OriginValueAndKind
+	// stops instead of walking it all the way to the bottom.
+	v := reflect.ValueOf(0)
+	for i := 0; i < 10; i++ {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+
+	out := OriginValueAndKind(v.Interface())
+	if out.OriginKind != reflect.Ptr {
+		t.Fatalf("OriginKind = %v, want Ptr (MaxDepth should stop resolution early)", out.OriginKind)
+	}
+}
+
+func TestOriginValueAndKind_UnwrapsNestedReflectValue(t *testing.T) {
+	n := 42
+	inner := reflect.ValueOf(n)
+	wrapped := reflect.ValueOf(inner)
+
+	out := OriginValueAndKind(wrapped)
+	if out.OriginKind != reflect.Int {
+		t.Fatalf("OriginKind = %v, want Int (nested reflect.Value must fully unwrap)", out.OriginKind)
+	}
+	if out.OriginValue.Int() != 42 {
+		t.Fatalf("OriginValue = %v, want 42", out.OriginValue.Int())
+	}
+}
+
+// TestOriginValueAndKind_UnexportedFieldDoesNotPanic pins a regression where
+// unwrapValue called v.Interface() without checking CanInterface() first:
+// given a reflect.Value obtained from an unexported struct field (which can't
+// be Interface()'d), it panicked instead of treating the value as already
+// fully unwrapped.
+func TestOriginValueAndKind_UnexportedFieldDoesNotPanic(t *testing.T) {
+	s := struct{ n int }{n: 9}
+	field := reflect.ValueOf(s).Field(0)
+
+	out := OriginValueAndKind(field)
+	if out.OriginKind != reflect.Int {
+		t.Fatalf("OriginKind = %v, want Int", out.OriginKind)
+	}
+}
+
+func TestOriginTypeAndKind_ResolvesPointerChain(t *testing.T) {
+	var p **int
+	out := OriginTypeAndKind(p)
+	if out.OriginKind != reflect.Int {
+		t.Fatalf("OriginKind = %v, want Int", out.OriginKind)
+	}
+}
+
+func TestOriginTypeAndKind_Nil(t *testing.T) {
+	out := OriginTypeAndKind(nil)
+	if out.InputType != nil || out.OriginType != nil {
+		t.Fatalf("expected zero-value output for nil input, got %+v", out)
+	}
+}
+
+func TestValueToInterface_InvalidValue(t *testing.T) {
+	_, ok := ValueToInterface(reflect.Value{})
+	if ok {
+		t.Fatal("ValueToInterface(invalid) should report ok=false")
+	}
+}
+
+// TestValueToInterface_ChasesPointer covers the CanInterface()==false path:
+// an unexported struct field holding a pointer can't be Interface()'d
+// directly, so ValueToInterface must recurse into it via Elem() instead of
+// giving up.
+func TestValueToInterface_ChasesPointer(t *testing.T) {
+	n := 7
+	s := struct{ p *int }{p: &n}
+
+	field := reflect.ValueOf(s).Field(0)
+	v, ok := ValueToInterface(field)
+	if !ok {
+		t.Fatal("ValueToInterface(unexported *int field) should succeed")
+	}
+	if v != int64(7) {
+		t.Fatalf("ValueToInterface(unexported *int field) = %v, want int64(7)", v)
+	}
+}
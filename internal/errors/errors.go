@@ -26,6 +26,11 @@ const (
 
 	// StackModeDetail specifies a detailed error stack, including framework details.
 	StackModeDetail StackMode = "detail"
+
+	// StackModeJSON specifies a structured error stack suitable for
+	// log/slog handlers that emit JSON, as opposed to the brief/detail
+	// modes' preformatted text.
+	StackModeJSON StackMode = "json"
 )
 
 // stackModeConfigured holds the configured error stack mode; it defaults to brief.
@@ -39,7 +44,7 @@ func init() {
 
 	// Configure error stack mode from command-line or environment.
 	if modeSetting := cmd.GetOptWithEnv(commandEnvKeyForStackMode); modeSetting != "" {
-		if mode := StackMode(modeSetting); mode == StackModeBrief || mode == StackModeDetail {
+		if mode := StackMode(modeSetting); mode == StackModeBrief || mode == StackModeDetail || mode == StackModeJSON {
 			stackModeConfigured = mode
 		}
 	}
@@ -49,3 +54,8 @@ func init() {
 func IsStackModeBrief() bool {
 	return stackModeConfigured == StackModeBrief
 }
+
+// IsStackModeJSON returns true if the current error stack mode is json.
+func IsStackModeJSON() bool {
+	return stackModeConfigured == StackModeJSON
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// This source code is governed by an MIT License.
+// See LICENSE file for full terms and conditions.
+
+package errors
+
+import (
+	"log/slog"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxLogValueStackDepth bounds the number of program counters captured by
+// StackFrames, mirroring pkg/errors' maxStackDepth.
+const maxLogValueStackDepth = 64
+
+// StackFrame describes a single frame of a captured call stack.
+type StackFrame struct {
+	// Function is the frame's fully qualified function name, e.g.
+	// "github.com/focela/aegis/internal/errors.StackFrames".
+	Function string
+	// File is the source file the frame's program counter maps to.
+	File string
+	// Line is the line within File.
+	Line int
+	// Package is Function with its trailing function/method name removed.
+	Package string
+	// IsFramework reports whether the active stack filter dropped this
+	// frame (see ApplyStackFilter, SetStackFilter).
+	IsFramework bool
+}
+
+// StackFrames captures the stack at the point of the call, skipping skip
+// additional frames on top of the call to StackFrames itself, and returns it
+// as structured StackFrame values.
+//
+// This package does not itself define an error type that records a stack at
+// wrap time (unlike pkg/errors' Stacker), so StackFrames reports the
+// caller's current stack rather than a stack captured when an error was
+// created; callers that want an error's own stack should call StackFrames
+// at the site where the error is produced or first observed.
+func StackFrames(skip int) []StackFrame {
+	var pcs [maxLogValueStackDepth]uintptr
+	n := runtime.Callers(2+skip, pcs[:])
+	if n == 0 {
+		return nil
+	}
+
+	all := make([]runtime.Frame, 0, n)
+	iter := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := iter.Next()
+		all = append(all, frame)
+		if !more {
+			break
+		}
+	}
+
+	kept := make(map[runtime.Frame]bool, len(all))
+	for _, frame := range ApplyStackFilter(all) {
+		kept[frame] = true
+	}
+
+	frames := make([]StackFrame, 0, len(all))
+	for _, frame := range all {
+		pkg, fn := splitFunctionPackage(frame.Function)
+		frames = append(frames, StackFrame{
+			Function:    fn,
+			File:        frame.File,
+			Line:        frame.Line,
+			Package:     pkg,
+			IsFramework: !kept[frame],
+		})
+	}
+	return frames
+}
+
+// splitFunctionPackage splits a runtime.Frame.Function value such as
+// "github.com/focela/aegis/internal/errors.StackFrames" into its package
+// path and bare function name.
+func splitFunctionPackage(function string) (pkg, name string) {
+	slash := strings.LastIndexByte(function, '/')
+	dot := strings.IndexByte(function[slash+1:], '.')
+	if dot < 0 {
+		return "", function
+	}
+	dot += slash + 1
+	return function[:dot], function[dot+1:]
+}
+
+// LogValue returns a slog.Value describing err, suitable for use as the
+// value of a log/slog attribute (or from an slog.LogValuer.LogValue method
+// on a type embedding or wrapping err). It reports a "msg" attribute from
+// err.Error(), a "code" attribute when err exposes one via an interface
+// with a Code() int method, and a "stack" attribute shaped by the
+// configured StackMode: a formatted string for StackModeBrief/StackModeDetail,
+// or structured []StackFrame for StackModeJSON.
+func LogValue(err error) slog.Value {
+	if err == nil {
+		return slog.Value{}
+	}
+
+	attrs := []slog.Attr{slog.String("msg", err.Error())}
+
+	if c, ok := err.(interface{ Code() int }); ok {
+		attrs = append(attrs, slog.Int("code", c.Code()))
+	}
+
+	frames := StackFrames(1)
+	if stackModeConfigured == StackModeJSON {
+		attrs = append(attrs, slog.Any("stack", frames))
+	} else {
+		attrs = append(attrs, slog.String("stack", formatStackFrames(frames)))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// formatStackFrames renders frames as one "function\n\tfile:line" entry per
+// frame. In brief mode, frames marked IsFramework are omitted.
+func formatStackFrames(frames []StackFrame) string {
+	var b strings.Builder
+	first := true
+	for _, frame := range frames {
+		if IsStackModeBrief() && frame.IsFramework {
+			continue
+		}
+		if !first {
+			b.WriteByte('\n')
+		}
+		first = false
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(frame.Line))
+	}
+	return b.String()
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// This source code is governed by an MIT License.
+// See LICENSE file for full terms and conditions.
+
+// Package errors configures error stack display modes for the Aegis project.
+package errors
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/focela/aegis/internal/cmd"
+	"github.com/focela/aegis/internal/config"
+)
+
+// commandEnvKeyForStackFilter is the command/env key for the composable
+// stack filter pattern, replacing the binary brief/detail toggle.
+const commandEnvKeyForStackFilter = "aegis.error.stack.filter"
+
+// stackFilterRule is a single +/- selector evaluated against a frame's
+// "pkg/func" string, modeled after Go's internal/bisect pattern language.
+type stackFilterRule struct {
+	keep    bool
+	prefix  string         // set for plain +prefix / -prefix rules.
+	re      *regexp.Regexp // set for +re:regex / -re:regex rules.
+	hashSel *hashSelector  // set for +yNNN / -nNNN bisect selectors.
+}
+
+// hashSelector stably hashes a frame's file:line and includes/excludes it by
+// comparing against a bisect-style pattern (y/n prefixed decimal literal).
+type hashSelector struct {
+	want uint64
+}
+
+var (
+	stackFilterMu    sync.RWMutex
+	stackFilterRules []stackFilterRule
+)
+
+func init() {
+	if pattern := cmd.GetOptWithEnv(commandEnvKeyForStackFilter); pattern != "" {
+		_ = SetStackFilter(pattern)
+	} else if stackModeConfigured == StackModeDetail {
+		_ = SetStackFilter("+*")
+	} else {
+		_ = SetStackFilter("-" + config.StackFilterKeyForAegis)
+	}
+}
+
+// SetStackFilter compiles pattern, a comma-separated list of rules evaluated
+// in order against each frame's "pkg/func" string (first match wins, default
+// keep), and installs it as the active stack filter:
+//
+//   - "+prefix" / "-prefix": keep/drop frames whose pkg/func starts with prefix.
+//   - "+re:regex" / "-re:regex": keep/drop frames whose pkg/func matches regex.
+//   - "+yNNN" / "-nNNN": keep/drop frames by a stable hash of file:line,
+//     letting a bisect search narrow down which frames cause noise without
+//     editing code.
+//
+// "*" as a prefix matches every frame, so "+*" keeps everything.
+func SetStackFilter(pattern string) error {
+	var rules []stackFilterRule
+
+	for _, part := range strings.Split(pattern, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var keep bool
+		switch part[0] {
+		case '+':
+			keep = true
+		case '-':
+			keep = false
+		default:
+			return fmt.Errorf("errors.SetStackFilter: rule %q must start with + or -", part)
+		}
+		selector := part[1:]
+
+		switch {
+		case selector == "*":
+			rules = append(rules, stackFilterRule{keep: keep, prefix: ""})
+		case strings.HasPrefix(selector, "re:"):
+			re, err := regexp.Compile(selector[len("re:"):])
+			if err != nil {
+				return fmt.Errorf("errors.SetStackFilter: invalid regex in rule %q: %v", part, err)
+			}
+			rules = append(rules, stackFilterRule{keep: keep, re: re})
+		case len(selector) > 0 && (selector[0] == 'y' || selector[0] == 'n'):
+			want, err := strconv.ParseUint(selector[1:], 10, 64)
+			if err != nil {
+				return fmt.Errorf("errors.SetStackFilter: invalid hash selector in rule %q: %v", part, err)
+			}
+			if selector[0] == 'n' {
+				// "n" selectors keep/drop the complementary half of the hash space.
+				want = ^want
+			}
+			rules = append(rules, stackFilterRule{keep: keep, hashSel: &hashSelector{want: want}})
+		default:
+			rules = append(rules, stackFilterRule{keep: keep, prefix: selector})
+		}
+	}
+
+	stackFilterMu.Lock()
+	stackFilterRules = rules
+	stackFilterMu.Unlock()
+	return nil
+}
+
+// matchesHashSelector reports whether file:line's stable hash agrees with
+// sel's retained bit pattern, using as many low bits as sel.want occupies.
+func (sel *hashSelector) matches(file string, line int) bool {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(file))
+	_, _ = h.Write([]byte{':'})
+	_, _ = h.Write([]byte(strconv.Itoa(line)))
+	sum := h.Sum64()
+
+	bits := 64
+	if sel.want != 0 {
+		bits = 64 - leadingZeros64(^sel.want)
+	}
+	if bits == 0 {
+		bits = 1
+	}
+	mask := uint64(1)<<uint(bits) - 1
+	return sum&mask == sel.want&mask
+}
+
+// leadingZeros64 counts leading zero bits of x.
+func leadingZeros64(x uint64) int {
+	n := 0
+	for i := 63; i >= 0; i-- {
+		if x&(uint64(1)<<uint(i)) != 0 {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// ApplyStackFilter filters frames using the active stack filter rules so
+// other packages (including pkg/errors' Wrap path) can share the same
+// filtering logic instead of hard-coding a framework prefix.
+func ApplyStackFilter(frames []runtime.Frame) []runtime.Frame {
+	stackFilterMu.RLock()
+	rules := stackFilterRules
+	stackFilterMu.RUnlock()
+
+	if len(rules) == 0 {
+		return frames
+	}
+
+	kept := make([]runtime.Frame, 0, len(frames))
+	for _, frame := range frames {
+		key := frame.Function
+		if keepFrame(rules, key, frame.File, frame.Line) {
+			kept = append(kept, frame)
+		}
+	}
+	return kept
+}
+
+// keepFrame evaluates rules in order against key (a frame's pkg/func string)
+// and its file:line, returning the first matching rule's verdict or true
+// (keep) if nothing matches.
+func keepFrame(rules []stackFilterRule, key, file string, line int) bool {
+	for _, rule := range rules {
+		switch {
+		case rule.hashSel != nil:
+			if rule.hashSel.matches(file, line) {
+				return rule.keep
+			}
+		case rule.re != nil:
+			if rule.re.MatchString(key) {
+				return rule.keep
+			}
+		default:
+			if rule.prefix == "" || strings.HasPrefix(key, rule.prefix) {
+				return rule.keep
+			}
+		}
+	}
+	return true
+}
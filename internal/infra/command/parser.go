@@ -0,0 +1,333 @@
+/*
+ * Copyright © 2024 Focela Technologies. All rights reserved.
+ *
+ * This source code is provided for viewing purposes only. Copying, modification,
+ * distribution, or use of this code is strictly prohibited without explicit
+ * written permission from Focela Technologies.
+ *
+ * This code is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND,
+ * either express or implied. For more information, see the LICENSE file or
+ * contact legal@focela.com.
+ */
+
+// Package command provides utilities for console operations like options/arguments parsing.
+package command
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OptionType identifies how a Parser option's raw string value(s) should be
+// converted for Parser.Get* access.
+type OptionType int
+
+const (
+	String OptionType = iota
+	Int
+	Bool
+	Duration
+	StringSlice
+)
+
+// OptionSpec declares a single option accepted by a Parser.
+type OptionSpec struct {
+	Short       string      // Single-character short flag, e.g. "v" for -v. Optional.
+	Type        OptionType  // How the raw value(s) are converted by the Get* accessors.
+	Default     interface{} // Value used when the option is neither on the command line nor in EnvFallback.
+	Required    bool        // If true, Parse fails with MissingRequiredError unless the option is set.
+	EnvFallback string      // Environment variable consulted if the option isn't on the command line.
+	Description string      // One-line description shown by Usage.
+}
+
+// Parser is a higher-level option parser built on top of
+// parseUsingDefaultAlgorithm: it adds typed access, short-flag grouping,
+// repeated-flag slices, subcommand dispatch, and generated usage text.
+type Parser struct {
+	name    string
+	specs   map[string]*OptionSpec
+	order   []string // registration order of specs, for Usage.
+	short   map[string]string
+	subs    map[string]*Parser
+	subOrdr []string
+
+	args   []string
+	values map[string][]string
+}
+
+// NewParser creates an empty Parser identified by name (used in Usage output).
+func NewParser(name string) *Parser {
+	return &Parser{
+		name:   name,
+		specs:  make(map[string]*OptionSpec),
+		short:  make(map[string]string),
+		subs:   make(map[string]*Parser),
+		values: make(map[string][]string),
+	}
+}
+
+// AddOption registers an option under name.
+func (p *Parser) AddOption(name string, opts OptionSpec) {
+	spec := opts
+	p.specs[name] = &spec
+	p.order = append(p.order, name)
+	if spec.Short != "" {
+		p.short[spec.Short] = name
+	}
+}
+
+// Subcommand registers sub to be dispatched to when the first positional
+// argument equals name; Parse then delegates the remaining arguments to it.
+func (p *Parser) Subcommand(name string, sub *Parser) {
+	p.subs[name] = sub
+	p.subOrdr = append(p.subOrdr, name)
+}
+
+// MissingRequiredError reports every required option that Parse could not
+// satisfy from the command line, the environment, or a default.
+type MissingRequiredError struct {
+	Options []string
+}
+
+func (e *MissingRequiredError) Error() string {
+	return fmt.Sprintf("missing required option(s): %s", strings.Join(e.Options, ", "))
+}
+
+// Parse processes args against p's registered options, recursing into a
+// matching Subcommand when the first positional argument names one.
+// "--" marks the end of options; everything after it is treated as
+// positional arguments (and is not scanned for a subcommand name).
+func (p *Parser) Parse(args []string) error {
+	p.values = make(map[string][]string)
+	p.args = nil
+
+	endOfOptions := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if !endOfOptions && arg == "--" {
+			endOfOptions = true
+			continue
+		}
+		if endOfOptions || !strings.HasPrefix(arg, "-") {
+			if !endOfOptions && len(p.args) == 0 {
+				if sub, ok := p.subs[arg]; ok {
+					return sub.Parse(args[i+1:])
+				}
+			}
+			p.args = append(p.args, arg)
+			continue
+		}
+
+		if consumed := p.consumeFlag(arg, args[i+1:]); consumed > 0 {
+			i += consumed - 1
+			continue
+		}
+	}
+
+	return p.checkRequired()
+}
+
+// consumeFlag parses a single "-x", "-abc" (grouped bools), "--name",
+// "--name=value", or "--name value" token, returning how many of the
+// remaining tokens (0 for the flag itself when it takes no extra argument,
+// 1 if it also consumed the following value token) were used, in addition
+// to the flag token itself.
+func (p *Parser) consumeFlag(arg string, rest []string) int {
+	name, value, hasValue := "", "", false
+
+	switch {
+	case strings.HasPrefix(arg, "--"):
+		body := arg[2:]
+		if eq := strings.IndexByte(body, '='); eq >= 0 {
+			name, value, hasValue = body[:eq], body[eq+1:], true
+		} else {
+			name = body
+		}
+	case len(arg) > 1:
+		body := arg[1:]
+		if eq := strings.IndexByte(body, '='); eq >= 0 {
+			short, val := body[:eq], body[eq+1:]
+			if long, ok := p.short[short]; ok {
+				p.values[long] = append(p.values[long], val)
+			}
+			return 1
+		}
+		// POSIX-style short flag grouping: -abc == -a -b -c, valid only when
+		// every letter names a bool flag.
+		if len(body) > 1 && p.allShortBools(body) {
+			for _, ch := range body {
+				long := p.short[string(ch)]
+				p.values[long] = append(p.values[long], "true")
+			}
+			return 1
+		}
+		if long, ok := p.short[body]; ok {
+			name = long
+		}
+	default:
+		return 1
+	}
+
+	spec, ok := p.specs[name]
+	if !ok {
+		return 1
+	}
+
+	if spec.Type == Bool && !hasValue {
+		p.values[name] = append(p.values[name], "true")
+		return 1
+	}
+	if hasValue {
+		p.values[name] = append(p.values[name], value)
+		return 1
+	}
+	if len(rest) > 0 && !strings.HasPrefix(rest[0], "-") {
+		p.values[name] = append(p.values[name], rest[0])
+		return 2
+	}
+	return 1
+}
+
+// allShortBools reports whether every rune in body names a registered Bool
+// short flag, making body eligible for -abc grouping.
+func (p *Parser) allShortBools(body string) bool {
+	for _, ch := range body {
+		long, ok := p.short[string(ch)]
+		if !ok {
+			return false
+		}
+		if spec := p.specs[long]; spec == nil || spec.Type != Bool {
+			return false
+		}
+	}
+	return true
+}
+
+// checkRequired returns a *MissingRequiredError listing every Required
+// option that has no command-line, environment, or default value.
+func (p *Parser) checkRequired() error {
+	var missing []string
+	for _, name := range p.order {
+		spec := p.specs[name]
+		if !spec.Required {
+			continue
+		}
+		if _, ok := p.values[name]; ok {
+			continue
+		}
+		if spec.EnvFallback != "" && os.Getenv(spec.EnvFallback) != "" {
+			continue
+		}
+		if spec.Default != nil {
+			continue
+		}
+		missing = append(missing, name)
+	}
+	if len(missing) > 0 {
+		return &MissingRequiredError{Options: missing}
+	}
+	return nil
+}
+
+// Args returns the positional (non-option) arguments from the last Parse call.
+func (p *Parser) Args() []string {
+	return p.args
+}
+
+// rawValues returns the raw string value(s) supplied for name, falling back
+// to EnvFallback and then Default.
+func (p *Parser) rawValues(name string) []string {
+	if v, ok := p.values[name]; ok {
+		return v
+	}
+	spec := p.specs[name]
+	if spec == nil {
+		return nil
+	}
+	if spec.EnvFallback != "" {
+		if v := os.Getenv(spec.EnvFallback); v != "" {
+			return []string{v}
+		}
+	}
+	if spec.Default != nil {
+		return []string{fmt.Sprint(spec.Default)}
+	}
+	return nil
+}
+
+// GetString returns name's value as a string.
+func (p *Parser) GetString(name string) string {
+	v := p.rawValues(name)
+	if len(v) == 0 {
+		return ""
+	}
+	return v[len(v)-1]
+}
+
+// GetInt returns name's value as an int, or 0 if unset or unparsable.
+func (p *Parser) GetInt(name string) int {
+	n, _ := strconv.Atoi(p.GetString(name))
+	return n
+}
+
+// GetBool returns name's value as a bool.
+func (p *Parser) GetBool(name string) bool {
+	b, _ := strconv.ParseBool(p.GetString(name))
+	return b
+}
+
+// GetDuration returns name's value as a time.Duration.
+func (p *Parser) GetDuration(name string) time.Duration {
+	d, _ := time.ParseDuration(p.GetString(name))
+	return d
+}
+
+// GetStringSlice returns every value supplied for a repeated flag, e.g.
+// --tag=x --tag=y yields []string{"x", "y"}.
+func (p *Parser) GetStringSlice(name string) []string {
+	if v, ok := p.values[name]; ok {
+		return v
+	}
+	spec := p.specs[name]
+	if spec != nil {
+		if slice, ok := spec.Default.([]string); ok {
+			return slice
+		}
+	}
+	return nil
+}
+
+// Usage returns a generated help message describing every registered option
+// and subcommand, suitable for printing on -h/--help.
+func (p *Parser) Usage() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage: %s [options]", p.name)
+	if len(p.subOrdr) > 0 {
+		b.WriteString(" <command>")
+	}
+	b.WriteString("\n")
+
+	if len(p.order) > 0 {
+		b.WriteString("\nOptions:\n")
+		for _, name := range p.order {
+			spec := p.specs[name]
+			flag := "--" + name
+			if spec.Short != "" {
+				flag = "-" + spec.Short + ", " + flag
+			}
+			fmt.Fprintf(&b, "  %-24s %s\n", flag, spec.Description)
+		}
+	}
+
+	if len(p.subOrdr) > 0 {
+		b.WriteString("\nCommands:\n")
+		for _, name := range p.subOrdr {
+			fmt.Fprintf(&b, "  %s\n", name)
+		}
+	}
+	return b.String()
+}
@@ -7,7 +7,13 @@ package kernel
 
 import (
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
+
+	"github.com/focela/loom/internal/core/copyset"
 )
 
 // Interface defines a custom deep copy behavior.
@@ -16,9 +22,62 @@ type Interface interface {
 	DeepCopy() interface{}
 }
 
-// Copy creates a deep copy of the given object and returns the copy.
-// If the object implements the Interface, its DeepCopy method will be used.
+// ChannelMode controls how CopyWith treats channel values.
+type ChannelMode int
+
+const (
+	// ChannelShare copies a channel by sharing the original channel value
+	// (the copy and the original read from/write to the same channel).
+	ChannelShare ChannelMode = iota
+	// ChannelNewEmpty allocates a new, empty channel of the same type and
+	// buffer size, sharing no state with the original.
+	ChannelNewEmpty
+	// ChannelSkip leaves the destination channel field as its zero value (nil).
+	ChannelSkip
+)
+
+// FuncMode controls how CopyWith treats func values.
+type FuncMode int
+
+const (
+	// FuncShare copies a func value by sharing the original closure.
+	FuncShare FuncMode = iota
+	// FuncNil leaves the destination func field as its zero value (nil).
+	FuncNil
+)
+
+// defaultMaxDepth bounds recursion when the caller does not set
+// CopyOptions.MaxDepth, guarding against unbounded recursion on a
+// pathological type graph.
+const defaultMaxDepth = 1000
+
+// CopyOptions controls the behavior of CopyWith.
+type CopyOptions struct {
+	// CopyUnexported, when true, copies unexported struct fields too (via
+	// unsafe.Pointer). By default unexported fields are left as their zero value.
+	CopyUnexported bool
+	// ChannelMode controls how channel values are copied. Defaults to ChannelShare.
+	ChannelMode ChannelMode
+	// FuncMode controls how func values are copied. Defaults to FuncShare.
+	FuncMode FuncMode
+	// MaxDepth bounds recursion depth. Defaults to defaultMaxDepth when <= 0.
+	MaxDepth int
+}
+
+// Copy creates a deep copy of the given object and returns the copy, using
+// the default CopyOptions (unexported fields zeroed, channels and funcs
+// shared). If the object implements the Interface, its DeepCopy method will
+// be used.
+// Cycles reached through pointers, slices, maps, or channels are detected: a
+// reference visited more than once in the same copy is given exactly one
+// copy, and every reference to it in the result points back to that same
+// copy, so copying a cyclic structure terminates instead of recursing forever.
 func Copy(src interface{}) interface{} {
+	return CopyWith(src, CopyOptions{})
+}
+
+// CopyWith is Copy with explicit CopyOptions.
+func CopyWith(src interface{}, opts CopyOptions) interface{} {
 	if src == nil {
 		return nil
 	}
@@ -36,17 +95,66 @@ func Copy(src interface{}) interface{} {
 			return v.DeepCopy()
 		}
 
+		if opts.MaxDepth <= 0 {
+			opts.MaxDepth = defaultMaxDepth
+		}
+
 		original := reflect.ValueOf(src)
 		dst := reflect.New(original.Type()).Elem()
 
-		// Perform recursive deep copy.
-		copyRecursive(original, dst)
+		c := &copyState{opts: opts, visited: make(copyset.Set)}
+		c.copyRecursive(original, dst, 0)
 		return dst.Interface()
 	}
 }
 
+// copyState tracks references already copied during a single Copy call,
+// keyed by their original address (and, for slices, length, via
+// copyset.Key), so cyclic and shared structures are copied once and every
+// reference resolves to the same copy.
+type copyState struct {
+	opts    CopyOptions
+	visited copyset.Set
+}
+
+var (
+	muType      = reflect.TypeOf(sync.Mutex{})
+	rwMuType    = reflect.TypeOf(sync.RWMutex{})
+	onceType    = reflect.TypeOf(sync.Once{})
+	atomicTypes = map[reflect.Type]struct{}{
+		reflect.TypeOf(atomic.Bool{}):   {},
+		reflect.TypeOf(atomic.Int32{}):  {},
+		reflect.TypeOf(atomic.Int64{}):  {},
+		reflect.TypeOf(atomic.Uint32{}): {},
+		reflect.TypeOf(atomic.Uint64{}): {},
+		reflect.TypeOf(atomic.Value{}):  {},
+	}
+)
+
+// isLockLikeType reports whether t is a sync/atomic type whose state should
+// never be copied (the destination is left at its zero value instead).
+//
+// atomic.Pointer[T] can't be listed in atomicTypes: Go generics give every
+// instantiation (atomic.Pointer[int], atomic.Pointer[MyConfig], ...) its own
+// distinct reflect.Type, so a literal entry would only ever match
+// atomic.Pointer[struct{}]. It's matched by package path and name prefix
+// instead, which covers every instantiation.
+func isLockLikeType(t reflect.Type) bool {
+	if t == muType || t == rwMuType || t == onceType {
+		return true
+	}
+	if _, ok := atomicTypes[t]; ok {
+		return true
+	}
+	return t.PkgPath() == "sync/atomic" && strings.HasPrefix(t.Name(), "Pointer[")
+}
+
 // copyRecursive performs the recursive deep copy operation.
-func copyRecursive(original, cpy reflect.Value) {
+func (c *copyState) copyRecursive(original, cpy reflect.Value, depth int) {
+	if depth >= c.opts.MaxDepth {
+		return
+	}
+
 	// Use custom DeepCopy if the type implements Interface.
 	if original.IsValid() && original.CanInterface() && !original.IsZero() {
 		if copier, ok := original.Interface().(Interface); ok {
@@ -58,19 +166,27 @@ func copyRecursive(original, cpy reflect.Value) {
 	// Handle specific types based on kind.
 	switch original.Kind() {
 	case reflect.Ptr:
-		originalValue := original.Elem()
-		if !originalValue.IsValid() {
+		if original.IsNil() {
+			return
+		}
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
 			return
 		}
-		cpy.Set(reflect.New(originalValue.Type()))
-		copyRecursive(originalValue, cpy.Elem())
+
+		originalValue := original.Elem()
+		copyPtr := reflect.New(originalValue.Type())
+		c.visited[key] = copyPtr
+		cpy.Set(copyPtr)
+		c.copyRecursive(originalValue, copyPtr.Elem(), depth+1)
 	case reflect.Interface:
 		if original.IsNil() {
 			return
 		}
 		originalValue := original.Elem()
 		copyValue := reflect.New(originalValue.Type()).Elem()
-		copyRecursive(originalValue, copyValue)
+		c.copyRecursive(originalValue, copyValue, depth+1)
 		cpy.Set(copyValue)
 	case reflect.Struct:
 		// Special case for time.Time
@@ -78,31 +194,91 @@ func copyRecursive(original, cpy reflect.Value) {
 			cpy.Set(reflect.ValueOf(t))
 			return
 		}
+		// sync primitives and atomic counters carry lock/generation state
+		// that must never be duplicated; leave the destination zeroed.
+		if isLockLikeType(original.Type()) {
+			return
+		}
 		for i := 0; i < original.NumField(); i++ {
-			if original.Type().Field(i).PkgPath != "" {
+			field := original.Type().Field(i)
+			if field.PkgPath != "" {
+				if !c.opts.CopyUnexported || !original.Field(i).CanAddr() {
+					continue
+				}
+				srcField := reflect.NewAt(field.Type, unsafe.Pointer(original.Field(i).UnsafeAddr())).Elem()
+				dstField := reflect.NewAt(field.Type, unsafe.Pointer(cpy.Field(i).UnsafeAddr())).Elem()
+				c.copyRecursive(srcField, dstField, depth+1)
 				continue
 			}
-			copyRecursive(original.Field(i), cpy.Field(i))
+			c.copyRecursive(original.Field(i), cpy.Field(i), depth+1)
 		}
 	case reflect.Slice:
 		if original.IsNil() {
 			return
 		}
-		cpy.Set(reflect.MakeSlice(original.Type(), original.Len(), original.Cap()))
+		key := copyset.SliceKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return
+		}
+
+		newSlice := reflect.MakeSlice(original.Type(), original.Len(), original.Cap())
+		c.visited[key] = newSlice
+		cpy.Set(newSlice)
 		for i := 0; i < original.Len(); i++ {
-			copyRecursive(original.Index(i), cpy.Index(i))
+			c.copyRecursive(original.Index(i), newSlice.Index(i), depth+1)
 		}
 	case reflect.Map:
 		if original.IsNil() {
 			return
 		}
-		cpy.Set(reflect.MakeMap(original.Type()))
+		key := copyset.RefKey(original)
+		if existing, ok := c.visited[key]; ok {
+			cpy.Set(existing)
+			return
+		}
+
+		newMap := reflect.MakeMap(original.Type())
+		c.visited[key] = newMap
+		cpy.Set(newMap)
 		for _, key := range original.MapKeys() {
 			originalValue := original.MapIndex(key)
 			copyValue := reflect.New(originalValue.Type()).Elem()
-			copyRecursive(originalValue, copyValue)
-			copyKey := Copy(key.Interface())
-			cpy.SetMapIndex(reflect.ValueOf(copyKey), copyValue)
+			c.copyRecursive(originalValue, copyValue, depth+1)
+			copiedKey := Copy(key.Interface())
+			newMap.SetMapIndex(reflect.ValueOf(copiedKey), copyValue)
+		}
+	case reflect.Chan:
+		if original.IsNil() {
+			return
+		}
+		switch c.opts.ChannelMode {
+		case ChannelSkip:
+			return
+		case ChannelNewEmpty:
+			key := copyset.RefKey(original)
+			if existing, ok := c.visited[key]; ok {
+				cpy.Set(existing)
+				return
+			}
+			newChan := reflect.MakeChan(original.Type(), original.Cap())
+			c.visited[key] = newChan
+			cpy.Set(newChan)
+		default: // ChannelShare
+			key := copyset.RefKey(original)
+			if existing, ok := c.visited[key]; ok {
+				cpy.Set(existing)
+				return
+			}
+			c.visited[key] = original
+			cpy.Set(original)
+		}
+	case reflect.Func:
+		if c.opts.FuncMode == FuncNil {
+			return
+		}
+		if !original.IsNil() {
+			cpy.Set(original)
 		}
 	default:
 		cpy.Set(original)
@@ -0,0 +1,38 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package kernel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/focela/loom/internal/entity/reflection"
+)
+
+// TestReflectionForwarders pins that kernel's deprecated entry points do
+// nothing but forward to the reflection package, so the two can't drift
+// again the way they did before this consolidation.
+func TestReflectionForwarders(t *testing.T) {
+	var zero int
+	p := &zero
+
+	got := OriginValueAndKind(p)
+	want := reflection.OriginValueAndKind(p)
+	if got.OriginKind != want.OriginKind {
+		t.Fatalf("kernel.OriginValueAndKind OriginKind = %v, want %v", got.OriginKind, want.OriginKind)
+	}
+
+	gotType := OriginTypeAndKind(p)
+	wantType := reflection.OriginTypeAndKind(p)
+	if gotType.OriginKind != wantType.OriginKind {
+		t.Fatalf("kernel.OriginTypeAndKind OriginKind = %v, want %v", gotType.OriginKind, wantType.OriginKind)
+	}
+
+	n := 9
+	v, ok := ValueToInterface(reflect.ValueOf(n))
+	if !ok || v != 9 {
+		t.Fatalf("kernel.ValueToInterface(9) = (%v, %v), want (9, true)", v, ok)
+	}
+}
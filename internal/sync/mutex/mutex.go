@@ -60,3 +60,21 @@ func (mu *Mutex) Unlock() {
 		mu.mutex.Unlock()
 	}
 }
+
+// TryLock attempts to acquire an exclusive lock without blocking. It returns
+// true if the lock was acquired, or if safety is disabled (in which case
+// locking is always a no-op success).
+func (mu *Mutex) TryLock() bool {
+	if mu.mutex == nil {
+		return true
+	}
+	return mu.mutex.TryLock()
+}
+
+// WithLock runs fn while holding an exclusive lock, releasing it via defer
+// even if fn panics. If safety is disabled, fn runs without locking.
+func (mu *Mutex) WithLock(fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	fn()
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+package mutex
+
+import (
+	"sync"
+)
+
+// RWMutex is a sync.RWMutex with a switch for concurrent safe feature.
+// If its underlying mutex is not nil, concurrent safety is enabled.
+// By default, the underlying mutex is nil, making this struct lightweight when safety isn't required.
+type RWMutex struct {
+	// mutex is the underlying sync.RWMutex for thread-safety.
+	// When nil, locking operations become no-ops for better performance.
+	mutex *sync.RWMutex
+}
+
+// NewRW creates and returns a new *RWMutex.
+// The optional parameter `safe` specifies whether to enable concurrent safety.
+// By default (without parameters), safety is disabled for better performance.
+func NewRW(safe ...bool) *RWMutex {
+	mu := CreateRW(safe...)
+	return &mu
+}
+
+// CreateRW returns a new RWMutex value (not a pointer).
+// The optional parameter `safe` specifies whether to enable concurrent safety.
+// By default (without parameters), safety is disabled for better performance.
+func CreateRW(safe ...bool) RWMutex {
+	if len(safe) > 0 && safe[0] {
+		return RWMutex{
+			mutex: new(sync.RWMutex),
+		}
+	}
+	return RWMutex{}
+}
+
+// IsSafe returns whether concurrent safety is enabled for this mutex.
+// Returns true if the underlying mutex is initialized, false otherwise.
+func (mu *RWMutex) IsSafe() bool {
+	return mu.mutex != nil
+}
+
+// Lock acquires an exclusive lock for writing.
+// If safety is disabled, this operation does nothing.
+func (mu *RWMutex) Lock() {
+	if mu.mutex != nil {
+		mu.mutex.Lock()
+	}
+}
+
+// Unlock releases an exclusive lock.
+// If safety is disabled, this operation does nothing.
+func (mu *RWMutex) Unlock() {
+	if mu.mutex != nil {
+		mu.mutex.Unlock()
+	}
+}
+
+// RLock acquires a shared lock for reading.
+// Multiple goroutines can hold read locks simultaneously.
+// If safety is disabled, this operation does nothing.
+func (mu *RWMutex) RLock() {
+	if mu.mutex != nil {
+		mu.mutex.RLock()
+	}
+}
+
+// RUnlock releases a shared lock.
+// If safety is disabled, this operation does nothing.
+func (mu *RWMutex) RUnlock() {
+	if mu.mutex != nil {
+		mu.mutex.RUnlock()
+	}
+}
+
+// TryLock attempts to acquire an exclusive lock without blocking. It returns
+// true if the lock was acquired, or if safety is disabled (in which case
+// locking is always a no-op success).
+func (mu *RWMutex) TryLock() bool {
+	if mu.mutex == nil {
+		return true
+	}
+	return mu.mutex.TryLock()
+}
+
+// TryRLock attempts to acquire a shared lock without blocking. It returns
+// true if the lock was acquired, or if safety is disabled.
+func (mu *RWMutex) TryRLock() bool {
+	if mu.mutex == nil {
+		return true
+	}
+	return mu.mutex.TryRLock()
+}
+
+// WithLock runs fn while holding an exclusive lock, releasing it via defer
+// even if fn panics. If safety is disabled, fn runs without locking.
+func (mu *RWMutex) WithLock(fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	fn()
+}
+
+// WithRLock runs fn while holding a shared lock, releasing it via defer even
+// if fn panics. If safety is disabled, fn runs without locking.
+func (mu *RWMutex) WithRLock(fn func()) {
+	mu.RLock()
+	defer mu.RUnlock()
+	fn()
+}
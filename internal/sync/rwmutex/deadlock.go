@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+package rwmutex
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/focela/loom/internal/debugger"
+)
+
+// DeadlockTimeout is how long Lock/RLock may block before this package logs
+// a possible-deadlock warning with the blocked goroutine's stack. Set to 0 to
+// disable the instrumentation entirely.
+var DeadlockTimeout = 10 * time.Second
+
+// warnOnSlowAcquire arranges for a possible-deadlock warning to be printed to
+// stderr if acquiring a lock takes longer than DeadlockTimeout. It must be
+// called from the goroutine about to block on Lock/RLock, before making that
+// call: time.AfterFunc runs its callback on a new goroutine, so the stack has
+// to be captured here, on the blocked goroutine, rather than inside the
+// callback. The returned func must be called once the lock has been
+// acquired, to cancel the warning.
+func warnOnSlowAcquire(op string) func() {
+	if DeadlockTimeout <= 0 {
+		return func() {}
+	}
+	stack := debugger.Stack(1)
+	timer := time.AfterFunc(DeadlockTimeout, func() {
+		fmt.Fprintf(os.Stderr,
+			"rwmutex: possible deadlock, %s blocked for over %s\n%s",
+			op, DeadlockTimeout, stack,
+		)
+	})
+	return func() { timer.Stop() }
+}
@@ -47,9 +47,13 @@ func (mu *RWMutex) IsSafe() bool {
 
 // Lock acquires an exclusive lock for writing.
 // If safety is disabled, this operation does nothing.
+// If acquiring the lock takes longer than DeadlockTimeout, a possible-deadlock
+// warning is logged with the blocked goroutine's stack.
 func (mu *RWMutex) Lock() {
 	if mu.mutex != nil {
+		cancelWarn := warnOnSlowAcquire("Lock()")
 		mu.mutex.Lock()
+		cancelWarn()
 	}
 }
 
@@ -64,9 +68,13 @@ func (mu *RWMutex) Unlock() {
 // RLock acquires a shared lock for reading.
 // Multiple goroutines can hold read locks simultaneously.
 // If safety is disabled, this operation does nothing.
+// If acquiring the lock takes longer than DeadlockTimeout, a possible-deadlock
+// warning is logged with the blocked goroutine's stack.
 func (mu *RWMutex) RLock() {
 	if mu.mutex != nil {
+		cancelWarn := warnOnSlowAcquire("RLock()")
 		mu.mutex.RLock()
+		cancelWarn()
 	}
 }
 
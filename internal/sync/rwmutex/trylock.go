@@ -0,0 +1,24 @@
+// Copyright (c) 2025 Focela Technologies.
+// This software is provided "as is", without any warranty.
+// Licensed under the MIT License – see LICENSE file for details.
+
+package rwmutex
+
+// TryLock attempts to acquire an exclusive lock without blocking. It returns
+// true if the lock was acquired, or if safety is disabled (in which case
+// locking is always a no-op success).
+func (mu *RWMutex) TryLock() bool {
+	if mu.mutex == nil {
+		return true
+	}
+	return mu.mutex.TryLock()
+}
+
+// TryRLock attempts to acquire a shared lock without blocking. It returns
+// true if the lock was acquired, or if safety is disabled.
+func (mu *RWMutex) TryRLock() bool {
+	if mu.mutex == nil {
+		return true
+	}
+	return mu.mutex.TryRLock()
+}
@@ -6,7 +6,9 @@
 package b64
 
 import (
+	"bytes"
 	"encoding/base64"
+	"io"
 	"os"
 
 	"github.com/focela/loom/pkg/errors"
@@ -30,6 +32,7 @@ func EncodeString(src string) string {
 }
 
 // EncodeFile encodes the content of a file at `path` using BASE64.
+// It reads the whole file into memory; for large files, use EncodeFileTo.
 func EncodeFile(path string) ([]byte, error) {
 	content, err := os.ReadFile(path)
 	if err != nil {
@@ -65,6 +68,27 @@ func MustEncodeFileToString(path string) string {
 	return result
 }
 
+// EncodeFileTo streams the content of the file at srcPath through
+// EncodeStream into the file at dstPath (created, or truncated if it
+// already exists), without buffering the whole file in memory the way
+// EncodeFile does. Use this for files too large to read whole.
+func EncodeFileTo(dstPath, srcPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return errors.Wrapf(err, `failed to open file "%s"`, srcPath)
+	}
+	defer src.Close()
+
+	dst, err := os.Create(dstPath)
+	if err != nil {
+		return errors.Wrapf(err, `failed to create file "%s"`, dstPath)
+	}
+	defer dst.Close()
+
+	_, err = EncodeStream(dst, src)
+	return err
+}
+
 // Decode decodes bytes using the BASE64 algorithm.
 func Decode(data []byte) ([]byte, error) {
 	src := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
@@ -112,3 +136,220 @@ func MustDecodeToString(data string) string {
 	}
 	return result
 }
+
+// EncodeStream copies src to dst, BASE64-encoding it as it streams, without
+// buffering the whole input in memory the way Encode does. It returns the
+// number of encoded bytes written to dst once src is fully consumed.
+func EncodeStream(dst io.Writer, src io.Reader) (int64, error) {
+	enc := base64.NewEncoder(base64.StdEncoding, dst)
+	n, err := io.Copy(enc, src)
+	if err != nil {
+		_ = enc.Close()
+		return n, errors.Wrap(err, "failed to stream-encode BASE64 data")
+	}
+	if err := enc.Close(); err != nil {
+		return n, errors.Wrap(err, "failed to close BASE64 stream encoder")
+	}
+	return n, nil
+}
+
+// DecodeStream copies src to dst, BASE64-decoding it as it streams, without
+// buffering the whole input in memory the way Decode does. It returns the
+// number of decoded bytes written to dst once src is fully consumed.
+func DecodeStream(dst io.Writer, src io.Reader) (int64, error) {
+	n, err := io.Copy(dst, base64.NewDecoder(base64.StdEncoding, src))
+	if err != nil {
+		return n, errors.Wrap(err, "failed to stream-decode BASE64 data")
+	}
+	return n, nil
+}
+
+// EncodeURL encodes bytes using the URL-safe BASE64 alphabet (with padding),
+// suitable for embedding in a URL path or query segment.
+func EncodeURL(src []byte) []byte {
+	dst := make([]byte, base64.URLEncoding.EncodedLen(len(src)))
+	base64.URLEncoding.Encode(dst, src)
+	return dst
+}
+
+// EncodeURLToString encodes bytes into a URL-safe BASE64 string.
+func EncodeURLToString(src []byte) string {
+	return string(EncodeURL(src))
+}
+
+// EncodeURLString encodes a string using the URL-safe BASE64 alphabet.
+func EncodeURLString(src string) string {
+	return EncodeURLToString([]byte(src))
+}
+
+// DecodeURL decodes URL-safe BASE64 bytes.
+func DecodeURL(data []byte) ([]byte, error) {
+	src := make([]byte, base64.URLEncoding.DecodedLen(len(data)))
+	n, err := base64.URLEncoding.Decode(src, data)
+	if err != nil {
+		return nil, errors.Wrap(err, `failed to decode URL-safe BASE64 bytes`)
+	}
+	return src[:n], nil
+}
+
+// DecodeURLString decodes a URL-safe BASE64 string into bytes.
+func DecodeURLString(data string) ([]byte, error) {
+	return DecodeURL([]byte(data))
+}
+
+// DecodeURLToString decodes a URL-safe BASE64 string into a regular string.
+func DecodeURLToString(data string) (string, error) {
+	b, err := DecodeURLString(data)
+	return string(b), err
+}
+
+// EncodeRawURL encodes bytes using the unpadded URL-safe BASE64 alphabet,
+// the form used by JWT and most OAuth-style payloads.
+func EncodeRawURL(src []byte) []byte {
+	dst := make([]byte, base64.RawURLEncoding.EncodedLen(len(src)))
+	base64.RawURLEncoding.Encode(dst, src)
+	return dst
+}
+
+// EncodeRawURLToString encodes bytes into an unpadded URL-safe BASE64 string.
+func EncodeRawURLToString(src []byte) string {
+	return string(EncodeRawURL(src))
+}
+
+// EncodeRawURLString encodes a string using the unpadded URL-safe BASE64 alphabet.
+func EncodeRawURLString(src string) string {
+	return EncodeRawURLToString([]byte(src))
+}
+
+// DecodeRawURL decodes unpadded URL-safe BASE64 bytes.
+func DecodeRawURL(data []byte) ([]byte, error) {
+	src := make([]byte, base64.RawURLEncoding.DecodedLen(len(data)))
+	n, err := base64.RawURLEncoding.Decode(src, data)
+	if err != nil {
+		return nil, errors.Wrap(err, `failed to decode unpadded URL-safe BASE64 bytes`)
+	}
+	return src[:n], nil
+}
+
+// DecodeRawURLString decodes an unpadded URL-safe BASE64 string into bytes.
+func DecodeRawURLString(data string) ([]byte, error) {
+	return DecodeRawURL([]byte(data))
+}
+
+// DecodeRawURLToString decodes an unpadded URL-safe BASE64 string into a regular string.
+func DecodeRawURLToString(data string) (string, error) {
+	b, err := DecodeRawURLString(data)
+	return string(b), err
+}
+
+// mimeLineLength is the maximum encoded line length RFC 2045 allows.
+const mimeLineLength = 76
+
+// EncodeMIME encodes src using BASE64, inserting a CRLF every 76 characters
+// per RFC 2045, for contexts (e.g. embedding in an email/MIME body) that
+// require wrapped output instead of Encode's single unbroken line.
+func EncodeMIME(src []byte) []byte {
+	encoded := Encode(src)
+	if len(encoded) <= mimeLineLength {
+		return encoded
+	}
+
+	var buf bytes.Buffer
+	for len(encoded) > mimeLineLength {
+		buf.Write(encoded[:mimeLineLength])
+		buf.WriteString("\r\n")
+		encoded = encoded[mimeLineLength:]
+	}
+	buf.Write(encoded)
+	return buf.Bytes()
+}
+
+// EncodeMIMEToString encodes src into a line-wrapped BASE64 string, as EncodeMIME.
+func EncodeMIMEToString(src []byte) string {
+	return string(EncodeMIME(src))
+}
+
+// DecodeMIME decodes BASE64 data that may contain RFC 2045 line breaks (CR,
+// LF, or both), as inserted by EncodeMIME or any other MIME encoder.
+func DecodeMIME(data []byte) ([]byte, error) {
+	stripped := bytes.Map(func(r rune) rune {
+		if r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, data)
+	return Decode(stripped)
+}
+
+// DecodeMIMEString decodes a line-wrapped BASE64 string, as DecodeMIME.
+func DecodeMIMEString(data string) (string, error) {
+	b, err := DecodeMIME([]byte(data))
+	return string(b), err
+}
+
+// Encoding wraps a *base64.Encoding so a caller can pick a custom alphabet
+// or padding once and reuse it, instead of choosing a different top-level
+// function per call the way Encode/EncodeURL/EncodeRawURL do for the common
+// cases.
+type Encoding struct {
+	enc *base64.Encoding
+}
+
+// NewEncoding wraps enc (e.g. base64.StdEncoding, base64.URLEncoding, a
+// custom alphabet built with base64.NewEncoding, or any of their
+// WithPadding variants) as an Encoding.
+func NewEncoding(enc *base64.Encoding) *Encoding {
+	return &Encoding{enc: enc}
+}
+
+// Encode encodes src using e's alphabet.
+func (e *Encoding) Encode(src []byte) []byte {
+	dst := make([]byte, e.enc.EncodedLen(len(src)))
+	e.enc.Encode(dst, src)
+	return dst
+}
+
+// EncodeToString encodes src into a string using e's alphabet.
+func (e *Encoding) EncodeToString(src []byte) string {
+	return string(e.Encode(src))
+}
+
+// Decode decodes data using e's alphabet.
+func (e *Encoding) Decode(data []byte) ([]byte, error) {
+	src := make([]byte, e.enc.DecodedLen(len(data)))
+	n, err := e.enc.Decode(src, data)
+	if err != nil {
+		return nil, errors.Wrap(err, `failed to decode BASE64 bytes`)
+	}
+	return src[:n], nil
+}
+
+// DecodeString decodes a string using e's alphabet.
+func (e *Encoding) DecodeString(data string) ([]byte, error) {
+	return e.Decode([]byte(data))
+}
+
+// EncodeStream copies src to dst, encoding it with e's alphabet as it
+// streams, without buffering the whole input in memory.
+func (e *Encoding) EncodeStream(dst io.Writer, src io.Reader) (int64, error) {
+	enc := base64.NewEncoder(e.enc, dst)
+	n, err := io.Copy(enc, src)
+	if err != nil {
+		_ = enc.Close()
+		return n, errors.Wrap(err, "failed to stream-encode BASE64 data")
+	}
+	if err := enc.Close(); err != nil {
+		return n, errors.Wrap(err, "failed to close BASE64 stream encoder")
+	}
+	return n, nil
+}
+
+// DecodeStream copies src to dst, decoding it with e's alphabet as it
+// streams, without buffering the whole input in memory.
+func (e *Encoding) DecodeStream(dst io.Writer, src io.Reader) (int64, error) {
+	n, err := io.Copy(dst, base64.NewDecoder(e.enc, src))
+	if err != nil {
+		return n, errors.Wrap(err, "failed to stream-decode BASE64 data")
+	}
+	return n, nil
+}
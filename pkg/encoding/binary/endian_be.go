@@ -7,61 +7,17 @@ package binary
 
 import (
 	"bytes"
-	"context"
 	"encoding/binary"
-	"fmt"
 	"math"
 
-	"github.com/focela/loom/internal/core"
 	"github.com/focela/loom/pkg/errors"
 )
 
-// BeEncode encodes multiple values into big-endian binary format.
+// BeEncode encodes multiple values into big-endian binary format. See
+// encodeValues, in endian_encoder.go, for the shared per-type dispatch this
+// and LeEncode both use.
 func BeEncode(values ...interface{}) []byte {
-	buf := new(bytes.Buffer)
-	for _, value := range values {
-		if value == nil {
-			return buf.Bytes()
-		}
-		switch v := value.(type) {
-		case int:
-			buf.Write(BeEncodeInt(v))
-		case int8:
-			buf.Write(BeEncodeInt8(v))
-		case int16:
-			buf.Write(BeEncodeInt16(v))
-		case int32:
-			buf.Write(BeEncodeInt32(v))
-		case int64:
-			buf.Write(BeEncodeInt64(v))
-		case uint:
-			buf.Write(BeEncodeUint(v))
-		case uint8:
-			buf.Write(BeEncodeUint8(v))
-		case uint16:
-			buf.Write(BeEncodeUint16(v))
-		case uint32:
-			buf.Write(BeEncodeUint32(v))
-		case uint64:
-			buf.Write(BeEncodeUint64(v))
-		case bool:
-			buf.Write(BeEncodeBool(v))
-		case string:
-			buf.Write(BeEncodeString(v))
-		case []byte:
-			buf.Write(v)
-		case float32:
-			buf.Write(BeEncodeFloat32(v))
-		case float64:
-			buf.Write(BeEncodeFloat64(v))
-		default:
-			if err := binary.Write(buf, binary.BigEndian, v); err != nil {
-				core.Errorf(context.TODO(), `%+v`, err)
-				buf.Write(BeEncodeString(fmt.Sprintf("%v", v)))
-			}
-		}
-	}
-	return buf.Bytes()
+	return encodeValues(beEndianEncoder{}, values...)
 }
 
 // BeEncodeByLength encodes values into a fixed-length byte slice.
@@ -176,10 +132,29 @@ func BeEncodeFloat64(f float64) []byte {
 	return b
 }
 
-// BeDecode decodes binary data into provided variables.
+// BeDecode decodes binary data into provided variables. A *Varint or
+// *Uvarint target is read as a variable-length integer, consuming only as
+// many bytes as it was encoded with, so fixed and variable fields can be
+// mixed in a single call.
 func BeDecode(b []byte, values ...interface{}) error {
 	buf := bytes.NewBuffer(b)
 	for _, value := range values {
+		switch v := value.(type) {
+		case *Varint:
+			n, err := ReadVarint(buf)
+			if err != nil {
+				return errors.Wrap(err, `binary.BeDecode: read varint failed`)
+			}
+			*v = Varint(n)
+			continue
+		case *Uvarint:
+			n, err := ReadUvarint(buf)
+			if err != nil {
+				return errors.Wrap(err, `binary.BeDecode: read uvarint failed`)
+			}
+			*v = Uvarint(n)
+			continue
+		}
 		if err := binary.Read(buf, binary.BigEndian, value); err != nil {
 			return errors.Wrap(err, `binary.Read failed`)
 		}
@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package binary provides APIs for handling binary/bytes data.
+package binary
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/focela/loom/internal/core"
+)
+
+// endianEncoder captures one byte order's per-type encoders, so LeEncode and
+// BeEncode can share a single type-switch instead of keeping two copies in
+// sync by hand.
+type endianEncoder interface {
+	byteOrder() binary.ByteOrder
+	encodeInt(int) []byte
+	encodeInt8(int8) []byte
+	encodeInt16(int16) []byte
+	encodeInt32(int32) []byte
+	encodeInt64(int64) []byte
+	encodeUint(uint) []byte
+	encodeUint8(uint8) []byte
+	encodeUint16(uint16) []byte
+	encodeUint32(uint32) []byte
+	encodeUint64(uint64) []byte
+	encodeBool(bool) []byte
+	encodeString(string) []byte
+	encodeFloat32(float32) []byte
+	encodeFloat64(float64) []byte
+	encodeVarint(int64) []byte
+	encodeUvarint(uint64) []byte
+}
+
+// leEndianEncoder implements endianEncoder over the LeEncode* family.
+type leEndianEncoder struct{}
+
+func (leEndianEncoder) byteOrder() binary.ByteOrder    { return binary.LittleEndian }
+func (leEndianEncoder) encodeInt(i int) []byte         { return LeEncodeInt(i) }
+func (leEndianEncoder) encodeInt8(i int8) []byte       { return LeEncodeInt8(i) }
+func (leEndianEncoder) encodeInt16(i int16) []byte     { return LeEncodeInt16(i) }
+func (leEndianEncoder) encodeInt32(i int32) []byte     { return LeEncodeInt32(i) }
+func (leEndianEncoder) encodeInt64(i int64) []byte     { return LeEncodeInt64(i) }
+func (leEndianEncoder) encodeUint(i uint) []byte       { return LeEncodeUint(i) }
+func (leEndianEncoder) encodeUint8(i uint8) []byte     { return LeEncodeUint8(i) }
+func (leEndianEncoder) encodeUint16(i uint16) []byte   { return LeEncodeUint16(i) }
+func (leEndianEncoder) encodeUint32(i uint32) []byte   { return LeEncodeUint32(i) }
+func (leEndianEncoder) encodeUint64(i uint64) []byte   { return LeEncodeUint64(i) }
+func (leEndianEncoder) encodeBool(b bool) []byte       { return LeEncodeBool(b) }
+func (leEndianEncoder) encodeString(s string) []byte   { return LeEncodeString(s) }
+func (leEndianEncoder) encodeFloat32(f float32) []byte { return LeEncodeFloat32(f) }
+func (leEndianEncoder) encodeFloat64(f float64) []byte { return LeEncodeFloat64(f) }
+func (leEndianEncoder) encodeVarint(i int64) []byte    { return LeEncodeVarint(i) }
+func (leEndianEncoder) encodeUvarint(i uint64) []byte  { return LeEncodeUvarint(i) }
+
+// beEndianEncoder implements endianEncoder over the BeEncode* family.
+type beEndianEncoder struct{}
+
+func (beEndianEncoder) byteOrder() binary.ByteOrder    { return binary.BigEndian }
+func (beEndianEncoder) encodeInt(i int) []byte         { return BeEncodeInt(i) }
+func (beEndianEncoder) encodeInt8(i int8) []byte       { return BeEncodeInt8(i) }
+func (beEndianEncoder) encodeInt16(i int16) []byte     { return BeEncodeInt16(i) }
+func (beEndianEncoder) encodeInt32(i int32) []byte     { return BeEncodeInt32(i) }
+func (beEndianEncoder) encodeInt64(i int64) []byte     { return BeEncodeInt64(i) }
+func (beEndianEncoder) encodeUint(i uint) []byte       { return BeEncodeUint(i) }
+func (beEndianEncoder) encodeUint8(i uint8) []byte     { return BeEncodeUint8(i) }
+func (beEndianEncoder) encodeUint16(i uint16) []byte   { return BeEncodeUint16(i) }
+func (beEndianEncoder) encodeUint32(i uint32) []byte   { return BeEncodeUint32(i) }
+func (beEndianEncoder) encodeUint64(i uint64) []byte   { return BeEncodeUint64(i) }
+func (beEndianEncoder) encodeBool(b bool) []byte       { return BeEncodeBool(b) }
+func (beEndianEncoder) encodeString(s string) []byte   { return BeEncodeString(s) }
+func (beEndianEncoder) encodeFloat32(f float32) []byte { return BeEncodeFloat32(f) }
+func (beEndianEncoder) encodeFloat64(f float64) []byte { return BeEncodeFloat64(f) }
+func (beEndianEncoder) encodeVarint(i int64) []byte    { return EncodeVarint(i) }
+func (beEndianEncoder) encodeUvarint(i uint64) []byte  { return EncodeUvarint(i) }
+
+// encodeValues is the type-switch shared by LeEncode and BeEncode: it
+// dispatches each value to enc's matching per-type encoder, falling back to
+// encoding/binary.Write, and on to a string conversion, exactly as the two
+// functions did before they were factored to share this one copy.
+func encodeValues(enc endianEncoder, values ...interface{}) []byte {
+	buf := new(bytes.Buffer)
+	for _, value := range values {
+		if value == nil {
+			return buf.Bytes()
+		}
+		switch v := value.(type) {
+		case int:
+			buf.Write(enc.encodeInt(v))
+		case int8:
+			buf.Write(enc.encodeInt8(v))
+		case int16:
+			buf.Write(enc.encodeInt16(v))
+		case int32:
+			buf.Write(enc.encodeInt32(v))
+		case int64:
+			buf.Write(enc.encodeInt64(v))
+		case uint:
+			buf.Write(enc.encodeUint(v))
+		case uint8:
+			buf.Write(enc.encodeUint8(v))
+		case uint16:
+			buf.Write(enc.encodeUint16(v))
+		case uint32:
+			buf.Write(enc.encodeUint32(v))
+		case uint64:
+			buf.Write(enc.encodeUint64(v))
+		case bool:
+			buf.Write(enc.encodeBool(v))
+		case string:
+			buf.Write(enc.encodeString(v))
+		case []byte:
+			buf.Write(v)
+		case float32:
+			buf.Write(enc.encodeFloat32(v))
+		case float64:
+			buf.Write(enc.encodeFloat64(v))
+		case Varint:
+			buf.Write(enc.encodeVarint(int64(v)))
+		case Uvarint:
+			buf.Write(enc.encodeUvarint(uint64(v)))
+		default:
+			if err := binary.Write(buf, enc.byteOrder(), v); err != nil {
+				core.Errorf(context.TODO(), `%+v`, err)
+				buf.Write(enc.encodeString(fmt.Sprintf("%v", v)))
+			}
+		}
+	}
+	return buf.Bytes()
+}
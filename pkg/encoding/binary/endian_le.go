@@ -7,61 +7,17 @@ package binary
 
 import (
 	"bytes"
-	"context"
 	"encoding/binary"
-	"fmt"
 	"math"
 
-	"github.com/focela/loom/internal/core"
 	"github.com/focela/loom/pkg/errors"
 )
 
-// LeEncode encodes multiple values into little-endian binary format.
+// LeEncode encodes multiple values into little-endian binary format. See
+// encodeValues, in endian_encoder.go, for the shared per-type dispatch this
+// and BeEncode both use.
 func LeEncode(values ...interface{}) []byte {
-	buf := new(bytes.Buffer)
-	for _, value := range values {
-		if value == nil {
-			return buf.Bytes()
-		}
-		switch v := value.(type) {
-		case int:
-			buf.Write(LeEncodeInt(v))
-		case int8:
-			buf.Write(LeEncodeInt8(v))
-		case int16:
-			buf.Write(LeEncodeInt16(v))
-		case int32:
-			buf.Write(LeEncodeInt32(v))
-		case int64:
-			buf.Write(LeEncodeInt64(v))
-		case uint:
-			buf.Write(LeEncodeUint(v))
-		case uint8:
-			buf.Write(LeEncodeUint8(v))
-		case uint16:
-			buf.Write(LeEncodeUint16(v))
-		case uint32:
-			buf.Write(LeEncodeUint32(v))
-		case uint64:
-			buf.Write(LeEncodeUint64(v))
-		case bool:
-			buf.Write(LeEncodeBool(v))
-		case string:
-			buf.Write(LeEncodeString(v))
-		case []byte:
-			buf.Write(v)
-		case float32:
-			buf.Write(LeEncodeFloat32(v))
-		case float64:
-			buf.Write(LeEncodeFloat64(v))
-		default:
-			if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
-				core.Errorf(context.TODO(), `%+v`, err)
-				buf.Write(LeEncodeString(fmt.Sprintf("%v", v)))
-			}
-		}
-	}
-	return buf.Bytes()
+	return encodeValues(leEndianEncoder{}, values...)
 }
 
 // LeEncodeByLength encodes values into a fixed-length byte slice.
@@ -176,10 +132,29 @@ func LeEncodeFloat64(f float64) []byte {
 	return b
 }
 
-// LeDecode decodes binary data into provided variables.
+// LeDecode decodes binary data into provided variables. A *Varint or
+// *Uvarint target is read as a variable-length integer, consuming only as
+// many bytes as it was encoded with, so fixed and variable fields can be
+// mixed in a single call.
 func LeDecode(b []byte, values ...interface{}) error {
 	buf := bytes.NewBuffer(b)
 	for _, value := range values {
+		switch v := value.(type) {
+		case *Varint:
+			n, err := LeReadVarint(buf)
+			if err != nil {
+				return errors.Wrap(err, `binary.LeDecode: read varint failed`)
+			}
+			*v = Varint(n)
+			continue
+		case *Uvarint:
+			n, err := LeReadUvarint(buf)
+			if err != nil {
+				return errors.Wrap(err, `binary.LeDecode: read uvarint failed`)
+			}
+			*v = Uvarint(n)
+			continue
+		}
 		if err := binary.Read(buf, binary.LittleEndian, value); err != nil {
 			return errors.Wrap(err, `binary.Read failed`)
 		}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package binary provides APIs for handling binary/bytes data.
+// It uses LittleEndian encoding by default.
+package binary
+
+// EncodeGray32 converts x to its reflected binary Gray code, where
+// consecutive values differ in exactly one bit. Useful for rotary/position
+// encoders and similar use cases pairing naturally with the package's bit API.
+func EncodeGray32(x uint32) uint32 {
+	return x ^ (x >> 1)
+}
+
+// DecodeGray32 reverses EncodeGray32.
+func DecodeGray32(g uint32) uint32 {
+	var x uint32
+	for ; g != 0; g >>= 1 {
+		x ^= g
+	}
+	return x
+}
+
+// EncodeGray64 converts x to its reflected binary Gray code.
+func EncodeGray64(x uint64) uint64 {
+	return x ^ (x >> 1)
+}
+
+// DecodeGray64 reverses EncodeGray64.
+func DecodeGray64(g uint64) uint64 {
+	var x uint64
+	for ; g != 0; g >>= 1 {
+		x ^= g
+	}
+	return x
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package pack implements a small self-describing binary codec in the style
+// of MessagePack: every encoded value is prefixed with a one-byte type tag,
+// so Unpack can walk a byte slice without a separate schema. Fixed- and
+// variable-width integers reuse pkg/encoding/binary's Le* primitives; the
+// tags themselves only distinguish which of those primitives was used.
+package pack
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/focela/loom/pkg/encoding/binary"
+	"github.com/focela/loom/pkg/errors"
+	"github.com/focela/loom/pkg/errors/code"
+)
+
+// Type tags. Each encoded value starts with exactly one of these bytes.
+//
+// tagShortStr occupies the range tagShortStr..tagShortStr+31 (0x40-0x5F): the
+// tag byte itself doubles as the string's length for strings of 0-31 bytes,
+// avoiding a separate length prefix for the common short-string case.
+const (
+	tagNil     byte = 0x00
+	tagFalse   byte = 0x01
+	tagTrue    byte = 0x02
+	tagInt8    byte = 0x10
+	tagInt16   byte = 0x11
+	tagInt32   byte = 0x12
+	tagInt64   byte = 0x13
+	tagVarint  byte = 0x14
+	tagUint8   byte = 0x20
+	tagUint16  byte = 0x21
+	tagUint32  byte = 0x22
+	tagUint64  byte = 0x23
+	tagUvarint byte = 0x24
+	tagFloat32 byte = 0x30
+	tagFloat64 byte = 0x31
+
+	tagShortStr    byte = 0x40
+	tagShortStrMax byte = tagShortStr + 31
+
+	tagBytes   byte = 0x60
+	tagLongStr byte = 0x61
+
+	tagArray byte = 0x70
+	tagMap   byte = 0x80
+)
+
+// Pack encodes values in order and concatenates the result, each prefixed
+// with its own type tag. Unpack, given the same number of pointers in the
+// same order, reverses the process.
+func Pack(values ...interface{}) ([]byte, error) {
+	var out []byte
+	for _, v := range values {
+		b, err := encodeValue(reflect.ValueOf(v))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+	}
+	return out, nil
+}
+
+// encodeValue dispatches rv to its tagged encoding by kind, recursing into
+// Array/Slice/Map/Struct. An invalid reflect.Value (as produced by
+// reflect.ValueOf(nil)) encodes as tagNil.
+func encodeValue(rv reflect.Value) ([]byte, error) {
+	if !rv.IsValid() {
+		return []byte{tagNil}, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return []byte{tagNil}, nil
+		}
+		return encodeValue(rv.Elem())
+
+	case reflect.Bool:
+		if rv.Bool() {
+			return []byte{tagTrue}, nil
+		}
+		return []byte{tagFalse}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return append([]byte{tagVarint}, binary.LeEncodeVarint(rv.Int())...), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return append([]byte{tagUvarint}, binary.LeEncodeUvarint(rv.Uint())...), nil
+
+	case reflect.Float32:
+		return append([]byte{tagFloat32}, binary.LeEncodeFloat32(float32(rv.Float()))...), nil
+
+	case reflect.Float64:
+		return append([]byte{tagFloat64}, binary.LeEncodeFloat64(rv.Float())...), nil
+
+	case reflect.String:
+		return encodeString(rv.String()), nil
+
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return encodeBytes(toBytes(rv)), nil
+		}
+		return encodeArray(rv)
+
+	case reflect.Map:
+		return encodeMap(rv)
+
+	case reflect.Struct:
+		return encodeStruct(rv)
+
+	default:
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "pack: unsupported type %s", rv.Type())
+	}
+}
+
+// toBytes copies a []byte or [N]byte-kind value into a fresh []byte, so the
+// caller doesn't need separate Slice/Array handling past this point.
+func toBytes(rv reflect.Value) []byte {
+	if rv.Kind() == reflect.Slice {
+		return rv.Bytes()
+	}
+	b := make([]byte, rv.Len())
+	reflect.Copy(reflect.ValueOf(b), rv)
+	return b
+}
+
+// encodeString picks the short-string tag (length folded into the tag byte)
+// for strings under 32 bytes, and the long-string tag (uvarint length
+// prefix) otherwise.
+func encodeString(s string) []byte {
+	if len(s) <= int(tagShortStrMax-tagShortStr) {
+		out := make([]byte, 1, 1+len(s))
+		out[0] = tagShortStr + byte(len(s))
+		return append(out, s...)
+	}
+	out := []byte{tagLongStr}
+	out = append(out, binary.LeEncodeUvarint(uint64(len(s)))...)
+	return append(out, s...)
+}
+
+// encodeBytes tags b with a uvarint length prefix, distinct from the string
+// tags so Unpack can tell "[]byte" and "string" payloads apart.
+func encodeBytes(b []byte) []byte {
+	out := []byte{tagBytes}
+	out = append(out, binary.LeEncodeUvarint(uint64(len(b)))...)
+	return append(out, b...)
+}
+
+// encodeArray tags rv with a uvarint element count, followed by each
+// element's own tagged encoding.
+func encodeArray(rv reflect.Value) ([]byte, error) {
+	out := []byte{tagArray}
+	out = append(out, binary.LeEncodeUvarint(uint64(rv.Len()))...)
+	for i := 0; i < rv.Len(); i++ {
+		elem, err := encodeValue(rv.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, elem...)
+	}
+	return out, nil
+}
+
+// encodeMap tags rv with a uvarint entry count, followed by each key/value
+// pair's own tagged encoding. Keys are sorted by their formatted string so
+// the output is deterministic across runs.
+func encodeMap(rv reflect.Value) ([]byte, error) {
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmtSprint(keys[i]) < fmtSprint(keys[j])
+	})
+
+	out := []byte{tagMap}
+	out = append(out, binary.LeEncodeUvarint(uint64(len(keys)))...)
+	for _, key := range keys {
+		k, err := encodeValue(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := encodeValue(rv.MapIndex(key))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, k...)
+		out = append(out, v...)
+	}
+	return out, nil
+}
+
+// encodeStruct tags rv as a map of field name to field value, skipping
+// unexported fields, fields tagged `packtag:"-"`, and (when the tag includes
+// ,omitempty) fields holding their type's zero value.
+func encodeStruct(rv reflect.Value) ([]byte, error) {
+	typ := rv.Type()
+
+	type entry struct {
+		name string
+		val  reflect.Value
+	}
+	var entries []entry
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, omitEmpty, skip := parsePackTag(field)
+		if skip {
+			continue
+		}
+		fv := rv.Field(i)
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		entries = append(entries, entry{name: name, val: fv})
+	}
+
+	out := []byte{tagMap}
+	out = append(out, binary.LeEncodeUvarint(uint64(len(entries)))...)
+	for _, e := range entries {
+		out = append(out, encodeString(e.name)...)
+		v, err := encodeValue(e.val)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v...)
+	}
+	return out, nil
+}
+
+// parsePackTag reads field's `packtag:"name,omitempty"` tag, defaulting name
+// to field.Name when the tag is absent or has no name component. A tag of
+// "-" means skip entirely.
+func parsePackTag(field reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	tag, ok := field.Tag.Lookup("packtag")
+	if !ok || tag == "" {
+		return field.Name, false, false
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+
+	name = field.Name
+	parts := splitTag(tag)
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
+
+// splitTag splits a struct tag value on commas without pulling in strings.Split
+// for what is, at most, a two-element split.
+func splitTag(tag string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			parts = append(parts, tag[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, tag[start:])
+	return parts
+}
+
+// fmtSprint formats a reflect.Value's underlying value for map-key sort
+// comparison, without importing fmt solely for %v on a handful of kinds.
+func fmtSprint(rv reflect.Value) string {
+	b, err := encodeValue(rv)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
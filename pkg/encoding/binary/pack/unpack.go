@@ -0,0 +1,437 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package pack
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+
+	"github.com/focela/loom/pkg/encoding/binary"
+	"github.com/focela/loom/pkg/errors"
+	"github.com/focela/loom/pkg/errors/code"
+)
+
+// Unpack decodes the tagged values in b into ptrs, in order. Each ptr must
+// be a non-nil pointer; a *interface{} target receives whichever of
+// bool/int64/uint64/float64/string/[]byte/[]interface{}/map[string]interface{}
+// matches the encoded tag, mirroring how Pack boxes values on the way in.
+func Unpack(b []byte, ptrs ...interface{}) error {
+	buf := bytes.NewReader(b)
+	for _, ptr := range ptrs {
+		rv := reflect.ValueOf(ptr)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return errors.NewCodef(code.CodeInvalidParameter, "pack: Unpack target must be a non-nil pointer, got %T", ptr)
+		}
+		if err := decodeValue(buf, rv.Elem()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeValue reads one tagged value from buf and stores it into rv. When rv
+// is an interface{} (or a pointer to one), the concrete Go type is chosen
+// from the tag itself rather than from rv, matching encodeValue's boxing.
+func decodeValue(buf *bytes.Reader, rv reflect.Value) error {
+	tag, err := buf.ReadByte()
+	if err != nil {
+		return errors.Wrap(err, `pack: read type tag failed`)
+	}
+
+	if tag != tagNil && rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+
+	switch {
+	case tag == tagNil:
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+
+	case tag == tagFalse:
+		return assignBool(rv, false)
+	case tag == tagTrue:
+		return assignBool(rv, true)
+
+	case tag == tagInt8, tag == tagInt16, tag == tagInt32, tag == tagInt64:
+		n, err := readFixedInt(buf, tag)
+		if err != nil {
+			return err
+		}
+		return assignInt(rv, n)
+	case tag == tagVarint:
+		n, err := binary.LeReadVarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read varint failed`)
+		}
+		return assignInt(rv, n)
+
+	case tag == tagUint8, tag == tagUint16, tag == tagUint32, tag == tagUint64:
+		n, err := readFixedUint(buf, tag)
+		if err != nil {
+			return err
+		}
+		return assignUint(rv, n)
+	case tag == tagUvarint:
+		n, err := binary.LeReadUvarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read uvarint failed`)
+		}
+		return assignUint(rv, n)
+
+	case tag == tagFloat32:
+		b4, err := readN(buf, 4)
+		if err != nil {
+			return err
+		}
+		return assignFloat(rv, float64(binary.LeDecodeToFloat32(b4)))
+	case tag == tagFloat64:
+		b8, err := readN(buf, 8)
+		if err != nil {
+			return err
+		}
+		return assignFloat(rv, binary.LeDecodeToFloat64(b8))
+
+	case tag >= tagShortStr && tag <= tagShortStrMax:
+		s, err := readN(buf, int(tag-tagShortStr))
+		if err != nil {
+			return err
+		}
+		return assignString(rv, string(s))
+	case tag == tagLongStr:
+		n, err := binary.LeReadUvarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read long-string length failed`)
+		}
+		s, err := readN(buf, int(n))
+		if err != nil {
+			return err
+		}
+		return assignString(rv, string(s))
+
+	case tag == tagBytes:
+		n, err := binary.LeReadUvarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read bytes length failed`)
+		}
+		raw, err := readN(buf, int(n))
+		if err != nil {
+			return err
+		}
+		return assignBytes(rv, raw)
+
+	case tag == tagArray:
+		n, err := binary.LeReadUvarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read array count failed`)
+		}
+		return decodeArray(buf, rv, int(n))
+
+	case tag == tagMap:
+		n, err := binary.LeReadUvarint(buf)
+		if err != nil {
+			return errors.Wrap(err, `pack: read map count failed`)
+		}
+		return decodeMap(buf, rv, int(n))
+
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: unknown type tag 0x%02x", tag)
+	}
+}
+
+// readN reads exactly n bytes from buf, wrapping a short read in a
+// code-carrying error so callers don't each repeat the same check.
+func readN(buf *bytes.Reader, n int) ([]byte, error) {
+	out := make([]byte, n)
+	if _, err := io.ReadFull(buf, out); err != nil {
+		return nil, errors.Wrap(err, `pack: truncated payload`)
+	}
+	return out, nil
+}
+
+// readFixedInt reads the fixed-width signed integer matching tag.
+func readFixedInt(buf *bytes.Reader, tag byte) (int64, error) {
+	switch tag {
+	case tagInt8:
+		b, err := readN(buf, 1)
+		if err != nil {
+			return 0, err
+		}
+		return int64(binary.LeDecodeToInt8(b)), nil
+	case tagInt16:
+		b, err := readN(buf, 2)
+		if err != nil {
+			return 0, err
+		}
+		return int64(binary.LeDecodeToInt16(b)), nil
+	case tagInt32:
+		b, err := readN(buf, 4)
+		if err != nil {
+			return 0, err
+		}
+		return int64(binary.LeDecodeToInt32(b)), nil
+	default: // tagInt64
+		b, err := readN(buf, 8)
+		if err != nil {
+			return 0, err
+		}
+		return binary.LeDecodeToInt64(b), nil
+	}
+}
+
+// readFixedUint reads the fixed-width unsigned integer matching tag.
+func readFixedUint(buf *bytes.Reader, tag byte) (uint64, error) {
+	switch tag {
+	case tagUint8:
+		b, err := readN(buf, 1)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.LeDecodeToUint8(b)), nil
+	case tagUint16:
+		b, err := readN(buf, 2)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.LeDecodeToUint16(b)), nil
+	case tagUint32:
+		b, err := readN(buf, 4)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.LeDecodeToUint32(b)), nil
+	default: // tagUint64
+		b, err := readN(buf, 8)
+		if err != nil {
+			return 0, err
+		}
+		return binary.LeDecodeToUint64(b), nil
+	}
+}
+
+// decodeArray fills rv, a slice or array, with n tagged elements. rv may also
+// be an interface{}, in which case the elements are decoded into a freshly
+// allocated []interface{}.
+func decodeArray(buf *bytes.Reader, rv reflect.Value, n int) error {
+	if rv.Kind() == reflect.Interface {
+		out := make([]interface{}, n)
+		for i := range out {
+			if err := decodeValue(buf, reflect.ValueOf(&out[i]).Elem()); err != nil {
+				return err
+			}
+		}
+		rv.Set(reflect.ValueOf(out))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		out := reflect.MakeSlice(rv.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := decodeValue(buf, out.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(out)
+		return nil
+	case reflect.Array:
+		for i := 0; i < n && i < rv.Len(); i++ {
+			if err := decodeValue(buf, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		for i := rv.Len(); i < n; i++ {
+			if err := decodeValue(buf, reflect.New(rv.Type().Elem()).Elem()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode array into %s", rv.Type())
+	}
+}
+
+// decodeMap fills rv, a map or struct, with n tagged key/value pairs. rv may
+// also be an interface{}, in which case the pairs are decoded into a freshly
+// allocated map[string]interface{}, matching encodeStruct's wire shape.
+func decodeMap(buf *bytes.Reader, rv reflect.Value, n int) error {
+	if rv.Kind() == reflect.Interface {
+		out := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			var key string
+			if err := decodeValue(buf, reflect.ValueOf(&key).Elem()); err != nil {
+				return err
+			}
+			var val interface{}
+			if err := decodeValue(buf, reflect.ValueOf(&val).Elem()); err != nil {
+				return err
+			}
+			out[key] = val
+		}
+		rv.Set(reflect.ValueOf(out))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		return decodeStruct(buf, rv, n)
+	case reflect.Map:
+		out := reflect.MakeMapWithSize(rv.Type(), n)
+		keyType, valType := rv.Type().Key(), rv.Type().Elem()
+		for i := 0; i < n; i++ {
+			key := reflect.New(keyType).Elem()
+			if err := decodeValue(buf, key); err != nil {
+				return err
+			}
+			val := reflect.New(valType).Elem()
+			if err := decodeValue(buf, val); err != nil {
+				return err
+			}
+			out.SetMapIndex(key, val)
+		}
+		rv.Set(out)
+		return nil
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode map into %s", rv.Type())
+	}
+}
+
+// assignBool stores b into rv, which must be a bool or interface{}.
+func assignBool(rv reflect.Value, b bool) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(b))
+		return nil
+	}
+	if rv.Kind() != reflect.Bool {
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode bool into %s", rv.Type())
+	}
+	rv.SetBool(b)
+	return nil
+}
+
+// assignInt stores n into rv, which must be a signed integer kind or
+// interface{}; decoding into an interface{} always boxes as int64, mirroring
+// how encodeValue always packs signed integers as a varint.
+func assignInt(rv reflect.Value, n int64) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(n))
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		rv.SetUint(uint64(n))
+		return nil
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode int into %s", rv.Type())
+	}
+}
+
+// assignUint stores n into rv, which must be an unsigned integer kind or
+// interface{}; decoding into an interface{} always boxes as uint64.
+func assignUint(rv reflect.Value, n uint64) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(n))
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		rv.SetUint(n)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(int64(n))
+		return nil
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode uint into %s", rv.Type())
+	}
+}
+
+// assignFloat stores f into rv, which must be a float kind or interface{}.
+func assignFloat(rv reflect.Value, f float64) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(f))
+		return nil
+	}
+	if rv.Kind() != reflect.Float32 && rv.Kind() != reflect.Float64 {
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode float into %s", rv.Type())
+	}
+	rv.SetFloat(f)
+	return nil
+}
+
+// assignString stores s into rv, which must be a string or interface{}.
+func assignString(rv reflect.Value, s string) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(s))
+		return nil
+	}
+	if rv.Kind() != reflect.String {
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode string into %s", rv.Type())
+	}
+	rv.SetString(s)
+	return nil
+}
+
+// assignBytes stores b into rv, which must be []byte, a fixed-size byte
+// array, or interface{}.
+func assignBytes(rv reflect.Value, b []byte) error {
+	if rv.Kind() == reflect.Interface {
+		rv.Set(reflect.ValueOf(b))
+		return nil
+	}
+	switch {
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		rv.SetBytes(b)
+		return nil
+	case rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8:
+		reflect.Copy(rv, reflect.ValueOf(b))
+		return nil
+	default:
+		return errors.NewCodef(code.CodeInvalidParameter, "pack: cannot decode bytes into %s", rv.Type())
+	}
+}
+
+// decodeStruct reads n field-name/value pairs and assigns each into the
+// struct field whose packtag (or name) matches, ignoring unknown fields so
+// Unpack tolerates payloads packed by a newer or older struct definition.
+func decodeStruct(buf *bytes.Reader, rv reflect.Value, n int) error {
+	typ := rv.Type()
+	fieldByName := make(map[string]int, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, _, skip := parsePackTag(field)
+		if skip {
+			continue
+		}
+		fieldByName[name] = i
+	}
+
+	for i := 0; i < n; i++ {
+		var key string
+		if err := decodeValue(buf, reflect.ValueOf(&key).Elem()); err != nil {
+			return err
+		}
+		idx, ok := fieldByName[key]
+		if !ok {
+			var discard interface{}
+			if err := decodeValue(buf, reflect.ValueOf(&discard).Elem()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := decodeValue(buf, rv.Field(idx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package binary provides APIs for handling binary/bytes data.
+// It uses LittleEndian encoding by default.
+package binary
+
+import (
+	"io"
+
+	"github.com/focela/loom/pkg/errors"
+)
+
+// Encoder writes little-endian encoded values to an underlying io.Writer,
+// so large payloads can be produced without building up an in-memory buffer.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes each value in order, using the same type switch as Encode.
+func (e *Encoder) Encode(values ...interface{}) error {
+	for _, value := range values {
+		if _, err := e.w.Write(Encode(value)); err != nil {
+			return errors.Wrap(err, `Encoder.Encode failed`)
+		}
+	}
+	return nil
+}
+
+// EncodeBytes writes p as-is.
+func (e *Encoder) EncodeBytes(p []byte) error {
+	_, err := e.w.Write(p)
+	return errors.Wrap(err, `Encoder.EncodeBytes failed`)
+}
+
+// Decoder reads little-endian encoded values from an underlying io.Reader, so
+// large payloads can be consumed without reading them fully into memory first.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// DecodeBytes reads and returns exactly n bytes.
+func (d *Decoder) DecodeBytes(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return nil, errors.Wrap(err, `Decoder.DecodeBytes failed`)
+	}
+	return buf, nil
+}
+
+// DecodeUint8 reads one byte as a uint8.
+func (d *Decoder) DecodeUint8() (uint8, error) {
+	b, err := d.DecodeBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToUint8(b), nil
+}
+
+// DecodeInt8 reads one byte as an int8.
+func (d *Decoder) DecodeInt8() (int8, error) {
+	b, err := d.DecodeBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToInt8(b), nil
+}
+
+// DecodeUint16 reads two bytes as a little-endian uint16.
+func (d *Decoder) DecodeUint16() (uint16, error) {
+	b, err := d.DecodeBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToUint16(b), nil
+}
+
+// DecodeInt16 reads two bytes as a little-endian int16.
+func (d *Decoder) DecodeInt16() (int16, error) {
+	b, err := d.DecodeBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToInt16(b), nil
+}
+
+// DecodeUint32 reads four bytes as a little-endian uint32.
+func (d *Decoder) DecodeUint32() (uint32, error) {
+	b, err := d.DecodeBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToUint32(b), nil
+}
+
+// DecodeInt32 reads four bytes as a little-endian int32.
+func (d *Decoder) DecodeInt32() (int32, error) {
+	b, err := d.DecodeBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToInt32(b), nil
+}
+
+// DecodeUint64 reads eight bytes as a little-endian uint64.
+func (d *Decoder) DecodeUint64() (uint64, error) {
+	b, err := d.DecodeBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToUint64(b), nil
+}
+
+// DecodeInt64 reads eight bytes as a little-endian int64.
+func (d *Decoder) DecodeInt64() (int64, error) {
+	b, err := d.DecodeBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToInt64(b), nil
+}
+
+// DecodeFloat32 reads four bytes as a little-endian float32.
+func (d *Decoder) DecodeFloat32() (float32, error) {
+	b, err := d.DecodeBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToFloat32(b), nil
+}
+
+// DecodeFloat64 reads eight bytes as a little-endian float64.
+func (d *Decoder) DecodeFloat64() (float64, error) {
+	b, err := d.DecodeBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return LeDecodeToFloat64(b), nil
+}
+
+// DecodeBool reads one byte as a bool.
+func (d *Decoder) DecodeBool() (bool, error) {
+	b, err := d.DecodeBytes(1)
+	if err != nil {
+		return false, err
+	}
+	return LeDecodeToBool(b), nil
+}
+
+// EncodeUvarint writes i as a variable-length unsigned integer.
+func (e *Encoder) EncodeUvarint(i uint64) error {
+	return e.EncodeBytes(EncodeUvarint(i))
+}
+
+// EncodeVarint writes i as a variable-length signed integer.
+func (e *Encoder) EncodeVarint(i int64) error {
+	return e.EncodeBytes(EncodeVarint(i))
+}
+
+// EncodeLengthDelimited writes p as a varint length prefix followed by p
+// itself, so a Decoder reading the same stream can frame it back out again.
+func (e *Encoder) EncodeLengthDelimited(p []byte) error {
+	return e.EncodeBytes(EncodeLengthDelimited(p))
+}
+
+// DecodeUvarint reads a variable-length unsigned integer one byte at a time,
+// since the encoded length isn't known up front.
+func (d *Decoder) DecodeUvarint() (uint64, error) {
+	var (
+		value uint64
+		shift uint
+	)
+	for {
+		b, err := d.DecodeBytes(1)
+		if err != nil {
+			return 0, err
+		}
+		value |= uint64(b[0]&0x7f) << shift
+		if b[0] < 0x80 {
+			return value, nil
+		}
+		shift += 7
+	}
+}
+
+// DecodeVarint reads a variable-length signed integer.
+func (d *Decoder) DecodeVarint() (int64, error) {
+	u, err := d.DecodeUvarint()
+	if err != nil {
+		return 0, err
+	}
+	return DecodeZigZag64(u), nil
+}
+
+// DecodeLengthDelimited reads a varint length prefix followed by that many
+// bytes of payload.
+func (d *Decoder) DecodeLengthDelimited() ([]byte, error) {
+	length, err := d.DecodeUvarint()
+	if err != nil {
+		return nil, err
+	}
+	return d.DecodeBytes(int(length))
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package binary provides APIs for handling binary/bytes data.
+// It uses LittleEndian encoding by default.
+package binary
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/focela/loom/pkg/errors"
+)
+
+// EncodeUvarint encodes i as a variable-length, base-128 unsigned integer
+// (the same format as encoding/binary.PutUvarint), using fewer bytes for
+// smaller values.
+func EncodeUvarint(i uint64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(b, i)
+	return b[:n]
+}
+
+// DecodeUvarint decodes a variable-length unsigned integer from the head of
+// b, returning the value and the number of bytes consumed. A returned length
+// of 0 or less indicates the buffer was empty, too short, or the encoded
+// value overflowed 64 bits.
+func DecodeUvarint(b []byte) (value uint64, length int) {
+	return binary.Uvarint(b)
+}
+
+// EncodeVarint encodes i as a variable-length, ZigZag-encoded signed integer
+// (the same format as encoding/binary.PutVarint).
+func EncodeVarint(i int64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(b, i)
+	return b[:n]
+}
+
+// DecodeVarint decodes a variable-length signed integer from the head of b,
+// returning the value and the number of bytes consumed. See DecodeUvarint for
+// the meaning of a non-positive length.
+func DecodeVarint(b []byte) (value int64, length int) {
+	return binary.Varint(b)
+}
+
+// PutUvarint encodes i as a variable-length unsigned integer into buf,
+// returning the number of bytes written. buf must be at least
+// binary.MaxVarintLen64 bytes long.
+func PutUvarint(buf []byte, i uint64) int {
+	return binary.PutUvarint(buf, i)
+}
+
+// PutVarint encodes i as a variable-length, ZigZag-encoded signed integer
+// into buf, returning the number of bytes written. buf must be at least
+// binary.MaxVarintLen64 bytes long.
+func PutVarint(buf []byte, i int64) int {
+	return binary.PutVarint(buf, i)
+}
+
+// ReadUvarint reads a variable-length unsigned integer from r, one byte at a
+// time, so the caller doesn't need the whole encoded value buffered up front.
+func ReadUvarint(r io.ByteReader) (uint64, error) {
+	return binary.ReadUvarint(r)
+}
+
+// ReadVarint reads a variable-length, ZigZag-encoded signed integer from r,
+// one byte at a time, so the caller doesn't need the whole encoded value
+// buffered up front.
+func ReadVarint(r io.ByteReader) (int64, error) {
+	return binary.ReadVarint(r)
+}
+
+// EncodeZigZag32 maps a signed int32 to an unsigned uint32 using ZigZag
+// encoding, so small-magnitude negative numbers also encode to a small
+// varint rather than a near-max-value one.
+func EncodeZigZag32(i int32) uint32 {
+	return uint32((i << 1) ^ (i >> 31))
+}
+
+// DecodeZigZag32 reverses EncodeZigZag32.
+func DecodeZigZag32(u uint32) int32 {
+	return int32((u >> 1) ^ -(u & 1))
+}
+
+// EncodeZigZag64 maps a signed int64 to an unsigned uint64 using ZigZag encoding.
+func EncodeZigZag64(i int64) uint64 {
+	return uint64((i << 1) ^ (i >> 63))
+}
+
+// DecodeZigZag64 reverses EncodeZigZag64.
+func DecodeZigZag64(u uint64) int64 {
+	return int64((u >> 1) ^ -(u & 1))
+}
+
+// EncodeLengthDelimited prefixes p with its length encoded as a varint,
+// producing a self-delimiting frame suitable for concatenation with other
+// frames in a stream.
+func EncodeLengthDelimited(p []byte) []byte {
+	prefix := EncodeUvarint(uint64(len(p)))
+	return append(prefix, p...)
+}
+
+// DecodeLengthDelimited reads one length-delimited frame from the head of b,
+// returning the frame payload and the remaining, unconsumed bytes.
+func DecodeLengthDelimited(b []byte) (payload []byte, rest []byte, err error) {
+	length, n := DecodeUvarint(b)
+	if n <= 0 {
+		return nil, b, errors.New(`binary.DecodeLengthDelimited: invalid varint length prefix`)
+	}
+	b = b[n:]
+	if uint64(len(b)) < length {
+		return nil, b, errors.New(`binary.DecodeLengthDelimited: truncated frame`)
+	}
+	return b[:length], b[length:], nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package binary provides APIs for handling binary/bytes data.
+package binary
+
+import (
+	"io"
+
+	"github.com/focela/loom/pkg/errors"
+)
+
+// Varint wraps an int64 so it can be passed to LeEncode/BeEncode and decoded
+// by LeDecode/BeDecode as a variable-length, ZigZag-encoded integer instead
+// of the fixed 8-byte int64 encoding the bare type would otherwise get.
+type Varint int64
+
+// Uvarint wraps a uint64 so it can be passed to LeEncode/BeEncode and
+// decoded by LeDecode/BeDecode as a variable-length unsigned integer instead
+// of the fixed 8-byte uint64 encoding the bare type would otherwise get.
+type Uvarint uint64
+
+// LeEncodeVarint encodes i as a variable-length, ZigZag-encoded signed
+// integer, under the Le* naming used by this file's other codecs. See
+// EncodeVarint for the wire format.
+func LeEncodeVarint(i int64) []byte {
+	return EncodeVarint(i)
+}
+
+// LeEncodeUvarint encodes i as a variable-length unsigned integer, under the
+// Le* naming used by this file's other codecs. See EncodeUvarint for the
+// wire format.
+func LeEncodeUvarint(i uint64) []byte {
+	return EncodeUvarint(i)
+}
+
+// LeDecodeVarint decodes a variable-length signed integer from the head of
+// b, returning the value and the number of bytes consumed, or an error if b
+// was empty, too short, or the encoded value overflowed 64 bits.
+func LeDecodeVarint(b []byte) (int64, int, error) {
+	value, n := DecodeVarint(b)
+	if n <= 0 {
+		return 0, 0, errors.New(`binary.LeDecodeVarint: invalid or truncated varint`)
+	}
+	return value, n, nil
+}
+
+// LeDecodeUvarint decodes a variable-length unsigned integer from the head
+// of b, returning the value and the number of bytes consumed, or an error if
+// b was empty, too short, or the encoded value overflowed 64 bits.
+func LeDecodeUvarint(b []byte) (uint64, int, error) {
+	value, n := DecodeUvarint(b)
+	if n <= 0 {
+		return 0, 0, errors.New(`binary.LeDecodeUvarint: invalid or truncated varint`)
+	}
+	return value, n, nil
+}
+
+// LeReadVarint reads a variable-length, ZigZag-encoded signed integer from
+// r, one byte at a time, so the caller doesn't need the whole encoded value
+// buffered up front.
+func LeReadVarint(r io.ByteReader) (int64, error) {
+	return ReadVarint(r)
+}
+
+// LeReadUvarint reads a variable-length unsigned integer from r, one byte at
+// a time, so the caller doesn't need the whole encoded value buffered up
+// front.
+func LeReadUvarint(r io.ByteReader) (uint64, error) {
+	return ReadUvarint(r)
+}
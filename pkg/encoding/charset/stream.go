@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package charset provides APIs for character-set conversion functionality.
+package charset
+
+import (
+	"bytes"
+	"io"
+	"unicode/utf8"
+
+	"github.com/focela/loom/pkg/errors"
+	"github.com/focela/loom/pkg/errors/code"
+
+	"golang.org/x/text/transform"
+)
+
+// NewReader returns an io.Reader that decodes r's bytes from srcCharset to
+// UTF-8 as they are read, rather than buffering the whole payload the way
+// Convert does. If srcCharset is already "UTF-8", r is returned unchanged.
+func NewReader(srcCharset string, r io.Reader) (io.Reader, error) {
+	if srcCharset == "UTF-8" {
+		return r, nil
+	}
+	enc := getEncoding(srcCharset)
+	if enc == nil {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "unsupported srcCharset '%s'", srcCharset)
+	}
+	return transform.NewReader(r, enc.NewDecoder()), nil
+}
+
+// NewWriter returns an io.WriteCloser that encodes UTF-8 bytes written to it
+// into dstCharset before forwarding them to w. Close must be called to flush
+// any bytes the encoder buffered internally; it does not close w. If
+// dstCharset is already "UTF-8", writes are forwarded to w unchanged.
+func NewWriter(dstCharset string, w io.Writer) (io.WriteCloser, error) {
+	if dstCharset == "UTF-8" {
+		return nopWriteCloser{w}, nil
+	}
+	enc := getEncoding(dstCharset)
+	if enc == nil {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "unsupported dstCharset '%s'", dstCharset)
+	}
+	return transform.NewWriter(w, enc.NewEncoder()), nil
+}
+
+// nopWriteCloser adapts an io.Writer that needs no flushing to io.WriteCloser.
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+// NewTransformer returns the transform.Transformer that converts bytes from
+// src to dst, the same conversion Convert performs on a string, for callers
+// who want to compose it with transform.NewReader/NewWriter or
+// transform.Bytes themselves instead of going through NewReader/NewWriter.
+// It bridges through UTF-8 only when neither src nor dst already is UTF-8.
+func NewTransformer(dst, src string) (transform.Transformer, error) {
+	if dst == src {
+		return transform.Nop, nil
+	}
+
+	if src == "UTF-8" {
+		enc := getEncoding(dst)
+		if enc == nil {
+			return nil, errors.NewCodef(code.CodeInvalidParameter, "unsupported dstCharset '%s'", dst)
+		}
+		return enc.NewEncoder(), nil
+	}
+
+	decoder := getEncoding(src)
+	if decoder == nil {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "unsupported srcCharset '%s'", src)
+	}
+	if dst == "UTF-8" {
+		return decoder.NewDecoder(), nil
+	}
+
+	encoder := getEncoding(dst)
+	if encoder == nil {
+		return nil, errors.NewCodef(code.CodeInvalidParameter, "unsupported dstCharset '%s'", dst)
+	}
+	return transform.Chain(decoder.NewDecoder(), encoder.NewEncoder()), nil
+}
+
+// bomSequences lists the BOMs DetectBOM recognizes, longest first so the
+// two-byte UTF-16 BOMs aren't matched as a prefix of some longer sequence.
+var bomSequences = []struct {
+	bom     []byte
+	charset string
+}{
+	{[]byte{0xEF, 0xBB, 0xBF}, "UTF-8"},
+	{[]byte{0xFE, 0xFF}, "UTF-16BE"},
+	{[]byte{0xFF, 0xFE}, "UTF-16LE"},
+}
+
+// DetectBOM peeks at the first bytes of r looking for a UTF-8/UTF-16BE/
+// UTF-16LE byte-order mark. It returns the charset the BOM signals, or "" if
+// none is found, plus a Reader that replays whatever bytes it had to peek
+// (with the BOM itself stripped when one was found) followed by the rest of r.
+func DetectBOM(r io.Reader) (charsetName string, remainder io.Reader) {
+	buf := make([]byte, 3)
+	n, _ := io.ReadFull(r, buf)
+	buf = buf[:n]
+
+	for _, seq := range bomSequences {
+		if bytes.HasPrefix(buf, seq.bom) {
+			return seq.charset, io.MultiReader(bytes.NewReader(buf[len(seq.bom):]), r)
+		}
+	}
+	return "", io.MultiReader(bytes.NewReader(buf), r)
+}
+
+// autoSampleSize is how much of r AutoReader inspects to guess its charset
+// when no BOM is present.
+const autoSampleSize = 4096
+
+// AutoReader returns an io.Reader that decodes r to UTF-8, choosing the
+// source charset itself: first via DetectBOM, then, absent a BOM, via a
+// byte-frequency heuristic distinguishing GBK, Big5, and Shift-JIS lead/trail
+// byte pairs from plain ASCII/UTF-8. It is meant for input whose charset the
+// caller has no other way to know (a pasted file, piped stdin); NewReader
+// should be preferred whenever the charset is actually known.
+func AutoReader(r io.Reader) (io.Reader, error) {
+	if bomCharset, rest := DetectBOM(r); bomCharset != "" {
+		return NewReader(bomCharset, rest)
+	}
+
+	sample := make([]byte, autoSampleSize)
+	n, _ := io.ReadFull(r, sample)
+	sample = sample[:n]
+
+	return NewReader(guessCharset(sample), io.MultiReader(bytes.NewReader(sample), r))
+}
+
+// guessCharset applies a lightweight byte-frequency heuristic to sample,
+// counting lead/trail byte pairs characteristic of GBK, Big5, and Shift-JIS.
+// It is a best-effort guess, not a validator: valid UTF-8 and ambiguous or
+// short samples both default to "UTF-8".
+func guessCharset(sample []byte) string {
+	if utf8.Valid(sample) {
+		return "UTF-8"
+	}
+
+	var gbkHits, big5Hits, sjisHits int
+	for i := 0; i < len(sample)-1; i++ {
+		lead, trail := sample[i], sample[i+1]
+		switch {
+		case lead >= 0xA1 && lead <= 0xF9 && ((trail >= 0x40 && trail <= 0x7E) || (trail >= 0xA1 && trail <= 0xFE)):
+			big5Hits++
+		case lead >= 0x81 && lead <= 0xFE && trail >= 0x40 && trail <= 0xFE && trail != 0x7F:
+			gbkHits++
+		case (lead >= 0x81 && lead <= 0x9F) || (lead >= 0xE0 && lead <= 0xFC):
+			if trail >= 0x40 && trail <= 0xFC && trail != 0x7F {
+				sjisHits++
+			}
+		}
+	}
+
+	switch {
+	case big5Hits >= gbkHits && big5Hits >= sjisHits && big5Hits > 0:
+		return "Big5"
+	case gbkHits >= sjisHits && gbkHits > 0:
+		return "GBK"
+	case sjisHits > 0:
+		return "ShiftJIS"
+	default:
+		return "UTF-8"
+	}
+}
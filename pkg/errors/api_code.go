@@ -108,29 +108,64 @@ func WrapCodeSkipf(code code.Code, skip int, err error, format string, args ...i
 
 // Code retrieves the error code from an error.
 // Returns `CodeNil` if the error does not have an associated code.
+//
+// The chain is walked breadth-first, so for a multi-error branch (anything
+// implementing Unwrap() []error, e.g. *Multi or stdlib errors.Join) the first
+// non-nil code found across all branches wins, favoring shallower errors over
+// deeper ones.
 func Code(err error) code.Code {
-	if err == nil {
-		return code.CodeNil
-	}
-	if e, ok := err.(Coder); ok {
-		return e.Code()
-	}
-	if e, ok := err.(Unwrapper); ok {
-		return Code(e.Unwrap())
-	}
-	return code.CodeNil
+	found := code.CodeNil
+	walkUnwrapBFS(err, func(e error) bool {
+		if c, ok := e.(Coder); ok {
+			if got := c.Code(); got != code.CodeNil {
+				found = got
+				return false
+			}
+		}
+		return true
+	})
+	return found
 }
 
 // HasCode checks if the error or any error in its chain has the specified error code.
-func HasCode(err error, code code.Code) bool {
-	if err == nil {
-		return false
-	}
-	if e, ok := err.(Coder); ok && code == e.Code() {
+// Like Code, it walks multi-error branches (Unwrap() []error) as well as the
+// single-error Unwrap() error chain.
+func HasCode(err error, target code.Code) bool {
+	found := false
+	walkUnwrapBFS(err, func(e error) bool {
+		if c, ok := e.(Coder); ok && c.Code() == target {
+			found = true
+			return false
+		}
 		return true
+	})
+	return found
+}
+
+// walkUnwrapBFS visits err and every error reachable from it via Unwrap()
+// error or Unwrap() []error, breadth-first, calling visit on each. It stops
+// as soon as visit returns false.
+func walkUnwrapBFS(err error, visit func(error) bool) {
+	if err == nil {
+		return
 	}
-	if e, ok := err.(Unwrapper); ok {
-		return HasCode(e.Unwrap(), code)
+
+	queue := []error{err}
+	for len(queue) > 0 {
+		e := queue[0]
+		queue = queue[1:]
+		if e == nil {
+			continue
+		}
+		if !visit(e) {
+			return
+		}
+
+		switch u := e.(type) {
+		case interface{ Unwrap() []error }:
+			queue = append(queue, u.Unwrap()...)
+		case Unwrapper:
+			queue = append(queue, u.Unwrap())
+		}
 	}
-	return false
 }
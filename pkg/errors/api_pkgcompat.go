@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+import (
+	"fmt"
+
+	"github.com/focela/loom/pkg/errors/code"
+)
+
+// WithStack annotates err with a stack trace at the point WithStack was
+// called, without adding any message, mirroring the pkg/errors package of
+// the same name. Returns nil if err is nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error: err,
+		stack: callers(),
+		code:  Code(err),
+	}
+}
+
+// WithStackSkip is WithStack with a skip count for the number of stack
+// frames to omit.
+func WithStackSkip(skip int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error: err,
+		stack: callers(skip),
+		code:  Code(err),
+	}
+}
+
+// WithMessage annotates err with message without recording a new stack
+// trace, mirroring the pkg/errors package of the same name. Returns nil if
+// err is nil.
+func WithMessage(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error: err,
+		text:  message,
+		code:  Code(err),
+	}
+}
+
+// WithMessagef is WithMessage with fmt.Sprintf-style formatting.
+func WithMessagef(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error: err,
+		text:  fmt.Sprintf(format, args...),
+		code:  Code(err),
+	}
+}
+
+// WithCode annotates err with code without adding a message or new stack
+// trace, useful for attaching a code.Code to an error produced elsewhere.
+func WithCode(c code.Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		error: err,
+		code:  c,
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+import (
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/focela/loom/pkg/errors/code"
+)
+
+// LogConfig describes the logging policy ShouldLog and Sampler enforce. It
+// mirrors the fields a caller's own logging config (e.g. an application's
+// model.LogConfig) typically exposes for this purpose.
+type LogConfig struct {
+	// SkipCode lists error codes, as the stringified result of
+	// code.Code.Code(), that should never be logged.
+	SkipCode []string
+
+	// Queue indicates the caller logs through a queue-based pipeline, where
+	// a Sampler should gate bursty error storms before they reach the
+	// backend. Sampler.ShouldLog only rate limits when this is set.
+	Queue bool
+}
+
+// Reason explains why ShouldLog allowed or dropped an error event.
+type Reason int
+
+const (
+	// ReasonAllow indicates the event should be logged.
+	ReasonAllow Reason = iota
+	// ReasonSkipCode indicates a code in err's chain matched cfg.SkipCode.
+	ReasonSkipCode
+	// ReasonRateLimited indicates a Sampler's token bucket for err's code was exhausted.
+	ReasonRateLimited
+)
+
+// String returns a human-readable name for r.
+func (r Reason) String() string {
+	switch r {
+	case ReasonAllow:
+		return "allow"
+	case ReasonSkipCode:
+		return "skip-code"
+	case ReasonRateLimited:
+		return "rate-limited"
+	default:
+		return "unknown"
+	}
+}
+
+// ShouldLog reports whether err should be logged under cfg, checking every
+// code in err's chain (walked the same way Code and Cause do) against
+// cfg.SkipCode. It does not rate limit; pair it with a Sampler for that, or
+// call Sampler.ShouldLog to get both checks in one call.
+func ShouldLog(err error, cfg LogConfig) (bool, Reason) {
+	if err == nil {
+		return false, ReasonSkipCode
+	}
+	if len(cfg.SkipCode) == 0 {
+		return true, ReasonAllow
+	}
+
+	skip := make(map[string]struct{}, len(cfg.SkipCode))
+	for _, c := range cfg.SkipCode {
+		skip[c] = struct{}{}
+	}
+
+	allow := true
+	walkUnwrapBFS(err, func(e error) bool {
+		c, ok := e.(Coder)
+		if !ok {
+			return true
+		}
+		if _, found := skip[strconv.Itoa(c.Code().Code())]; found {
+			allow = false
+			return false
+		}
+		return true
+	})
+	if !allow {
+		return false, ReasonSkipCode
+	}
+	return true, ReasonAllow
+}
+
+// tokenBucket is a Sampler's per-key rate limit state.
+type tokenBucket struct {
+	tokens  float64
+	last    time.Time
+	allowed uint64
+	dropped uint64
+}
+
+// Sampler rate-limits log events per error code using an independent
+// token bucket for each code, so a storm of one recurring error can't starve
+// out the log backend for everything else. It is safe for concurrent use.
+type Sampler struct {
+	perSecond float64
+	burst     float64
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewSampler creates a Sampler that allows up to perSecond events per code
+// each second, with a burst allowance of up to burst events before the rate
+// limit engages.
+func NewSampler(perSecond int, burst int) *Sampler {
+	return &Sampler{
+		perSecond: float64(perSecond),
+		burst:     float64(burst),
+		buckets:   make(map[string]*tokenBucket),
+	}
+}
+
+// Allow reports whether an event for err passes s's rate limit. Events are
+// keyed by the first code found in err's chain, falling back to a hash of
+// the root cause's message when err carries no code, so unrelated uncoded
+// errors don't share a single bucket.
+func (s *Sampler) Allow(err error) bool {
+	return s.allow(sampleKey(err), time.Now())
+}
+
+// ShouldLog is like the package-level ShouldLog, but additionally consults
+// s's token buckets when cfg.Queue is set. This is the entry point a
+// queue-based log pipeline should call for every event, so that a burst of
+// one recurring code is throttled before it reaches the backend.
+func (s *Sampler) ShouldLog(err error, cfg LogConfig) (bool, Reason) {
+	if ok, reason := ShouldLog(err, cfg); !ok {
+		return ok, reason
+	}
+	if cfg.Queue && !s.Allow(err) {
+		return false, ReasonRateLimited
+	}
+	return true, ReasonAllow
+}
+
+// SamplerStats is a point-in-time snapshot of a Sampler's counters.
+type SamplerStats struct {
+	Allowed uint64
+	Dropped uint64
+	Buckets int
+}
+
+// Stats returns a snapshot of s's aggregate allow/drop counters and the
+// number of distinct buckets (codes or hashed messages) it is tracking.
+func (s *Sampler) Stats() SamplerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stats SamplerStats
+	stats.Buckets = len(s.buckets)
+	for _, b := range s.buckets {
+		stats.Allowed += b.allowed
+		stats.Dropped += b.dropped
+	}
+	return stats
+}
+
+// allow applies the token-bucket algorithm for key at time now, refilling
+// tokens since the bucket's last access before checking availability.
+func (s *Sampler) allow(key string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: s.burst, last: now}
+		s.buckets[key] = b
+	} else {
+		if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+			b.tokens += elapsed * s.perSecond
+			if b.tokens > s.burst {
+				b.tokens = s.burst
+			}
+			b.last = now
+		}
+	}
+
+	if b.tokens < 1 {
+		b.dropped++
+		return false
+	}
+	b.tokens--
+	b.allowed++
+	return true
+}
+
+// sampleKey derives the Sampler bucket key for err: the first code found in
+// its chain, or a hash of its root cause's message when no code exists.
+func sampleKey(err error) string {
+	if c := Code(err); c != code.CodeNil {
+		return strconv.Itoa(c.Code())
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(Cause(err).Error()))
+	return "msg:" + strconv.FormatUint(uint64(h.Sum32()), 16)
+}
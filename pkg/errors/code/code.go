@@ -5,6 +5,10 @@
 // Package code provides structures and utilities for managing error codes.
 package code
 
+import (
+	"google.golang.org/grpc/codes"
+)
+
 // Code is a universal error code interface definition.
 type Code interface {
 	// Code returns the integer number of the current error code.
@@ -19,54 +23,111 @@ type Code interface {
 // Reserved internal error codes by the framework: code < 1000.
 var (
 	// General Codes
-	CodeNil            = localCode{-1, "", nil}                // No error code specified.
-	CodeOK             = localCode{0, "OK", nil}               // Everything is fine.
-	CodeUnknown        = localCode{64, "Unknown Error", nil}   // Unknown error.
-	CodeNotFound       = localCode{65, "Not Found", nil}       // Resource does not exist.
-	CodeInvalidRequest = localCode{66, "Invalid Request", nil} // Invalid request.
+	CodeNil            = localCode{code: -1}                                                                         // No error code specified.
+	CodeOK             = localCode{code: 0, message: "OK"}                                                           // Everything is fine.
+	CodeUnknown        = localCode{code: 64, message: "Unknown Error"}                                               // Unknown error.
+	CodeNotFound       = localCode{code: 65, message: "Not Found", httpStatus: 404, grpcStatus: int(codes.NotFound)} // Resource does not exist.
+	CodeInvalidRequest = localCode{code: 66, message: "Invalid Request"}                                             // Invalid request.
 
 	// Internal Codes
-	CodeInternalError = localCode{50, "Internal Error", nil} // An error occurred internally.
-	CodeInternalPanic = localCode{68, "Internal Panic", nil} // A panic occurred internally.
-	CodeServerBusy    = localCode{63, "Server Is Busy", nil} // Server is busy, please try again later.
+	CodeInternalError = localCode{code: 50, message: "Internal Error", httpStatus: 500, grpcStatus: int(codes.Internal)}    // An error occurred internally.
+	CodeInternalPanic = localCode{code: 68, message: "Internal Panic", httpStatus: 500, grpcStatus: int(codes.Internal)}    // A panic occurred internally.
+	CodeServerBusy    = localCode{code: 63, message: "Server Is Busy", httpStatus: 503, grpcStatus: int(codes.Unavailable)} // Server is busy, please try again later.
 
 	// Validation & Configuration Codes
-	CodeValidationFailed     = localCode{51, "Validation Failed", nil}     // Data validation failed.
-	CodeInvalidParameter     = localCode{53, "Invalid Parameter", nil}     // Invalid parameter.
-	CodeMissingParameter     = localCode{54, "Missing Parameter", nil}     // Missing parameter.
-	CodeInvalidConfiguration = localCode{56, "Invalid Configuration", nil} // Invalid configuration.
-	CodeMissingConfiguration = localCode{57, "Missing Configuration", nil} // Missing configuration.
+	CodeValidationFailed     = localCode{code: 51, message: "Validation Failed", httpStatus: 400, grpcStatus: int(codes.InvalidArgument)} // Data validation failed.
+	CodeInvalidParameter     = localCode{code: 53, message: "Invalid Parameter"}                                                          // Invalid parameter.
+	CodeMissingParameter     = localCode{code: 54, message: "Missing Parameter"}                                                          // Missing parameter.
+	CodeInvalidConfiguration = localCode{code: 56, message: "Invalid Configuration"}                                                      // Invalid configuration.
+	CodeMissingConfiguration = localCode{code: 57, message: "Missing Configuration"}                                                      // Missing configuration.
 
 	// Authorization & Security Codes
-	CodeNotAuthorized  = localCode{61, "Not Authorized", nil}  // Not authorized.
-	CodeSecurityReason = localCode{62, "Security Reason", nil} // Security-related issue.
+	CodeNotAuthorized  = localCode{code: 61, message: "Not Authorized", httpStatus: 401, grpcStatus: int(codes.Unauthenticated)} // Not authorized.
+	CodeSecurityReason = localCode{code: 62, message: "Security Reason"}                                                         // Security-related issue.
 
 	// Operation & Support Codes
-	CodeInvalidOperation = localCode{55, "Invalid Operation", nil} // Invalid operation.
-	CodeOperationFailed  = localCode{60, "Operation Failed", nil}  // Operation failed.
-	CodeNotImplemented   = localCode{58, "Not Implemented", nil}   // Not implemented yet.
-	CodeNotSupported     = localCode{59, "Not Supported", nil}     // Operation not supported.
+	CodeInvalidOperation = localCode{code: 55, message: "Invalid Operation"}                                                      // Invalid operation.
+	CodeOperationFailed  = localCode{code: 60, message: "Operation Failed"}                                                       // Operation failed.
+	CodeNotImplemented   = localCode{code: 58, message: "Not Implemented", httpStatus: 501, grpcStatus: int(codes.Unimplemented)} // Not implemented yet.
+	CodeNotSupported     = localCode{code: 59, message: "Not Supported"}                                                          // Operation not supported.
 
 	// Business Logic Codes
-	CodeBusinessValidationFailed = localCode{300, "Business Validation Failed", nil} // Business validation failed.
+	CodeBusinessValidationFailed = localCode{code: 300, message: "Business Validation Failed"} // Business validation failed.
 )
 
-// New creates and returns a new error code.
-// It generates a localCode instance with the specified code, message, and detail.
-func New(code int, message string, detail interface{}) Code {
-	return localCode{
+func init() {
+	for _, c := range []localCode{
+		CodeNil, CodeOK, CodeUnknown, CodeNotFound, CodeInvalidRequest,
+		CodeInternalError, CodeInternalPanic, CodeServerBusy,
+		CodeValidationFailed, CodeInvalidParameter, CodeMissingParameter,
+		CodeInvalidConfiguration, CodeMissingConfiguration,
+		CodeNotAuthorized, CodeSecurityReason,
+		CodeInvalidOperation, CodeOperationFailed, CodeNotImplemented, CodeNotSupported,
+		CodeBusinessValidationFailed,
+	} {
+		_ = DefaultRegistry.Register(c)
+	}
+}
+
+// New creates and returns a new error code. It generates a localCode
+// instance with the specified code, message, and detail; opts (e.g.
+// WithHTTPStatus, WithGRPCStatus, WithSeverity) attach the code's transport
+// mapping and severity once, so they're then discoverable by number through
+// HTTPStatus/GRPCStatus/CodeSeverity without every call site switching on it.
+func New(code int, message string, detail interface{}, opts ...Option) Code {
+	c := localCode{
 		code:    code,
 		message: message,
 		detail:  detail,
 	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
 }
 
-// WithCode creates and returns a new error code based on an existing Code instance.
-// The code and message are copied from the given `code`, while the detail comes from the provided `detail`.
-func WithCode(code Code, detail interface{}) Code {
+// NewWithHTTPStatus creates and returns a new error code that additionally
+// maps to the given HTTP status, so errors.WrapCode consumers can derive
+// http.StatusCode() from the code instead of switching on it at every call site.
+func NewWithHTTPStatus(code int, message string, httpStatus int) Code {
 	return localCode{
-		code:    code.Code(),
-		message: code.Message(),
-		detail:  detail,
+		code:       code,
+		message:    message,
+		httpStatus: httpStatus,
+	}
+}
+
+// NewWithGRPCStatus creates and returns a new error code that additionally
+// maps to the given gRPC status (a google.golang.org/grpc/codes.Code value),
+// so consumers can derive grpc.Code() from the code instead of switching on
+// it at every call site.
+func NewWithGRPCStatus(code int, message string, grpcStatus int) Code {
+	return localCode{
+		code:       code,
+		message:    message,
+		grpcStatus: grpcStatus,
+	}
+}
+
+// WithCode creates and returns a new error code based on an existing Code
+// instance. The code and message are copied from the given `code`, along
+// with its transport mapping and severity, while the detail comes from the
+// provided `detail`; opts override any of those copied fields.
+func WithCode(code Code, detail interface{}, opts ...Option) Code {
+	httpStatus, grpcStatus, severity := 0, 0, SeverityUnspecified
+	if lc, ok := code.(localCode); ok {
+		httpStatus, grpcStatus, severity = lc.httpStatus, lc.grpcStatus, lc.severity
+	}
+	c := localCode{
+		code:       code.Code(),
+		message:    code.Message(),
+		detail:     detail,
+		httpStatus: httpStatus,
+		grpcStatus: grpcStatus,
+		severity:   severity,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
+	return c
 }
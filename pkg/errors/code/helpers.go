@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package code provides structures and utilities for managing error codes.
+package code
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// HTTPStatus returns the HTTP status c maps to, or 0 if c does not implement
+// TransportCode or was registered without one.
+func HTTPStatus(c Code) int {
+	if tc, ok := c.(TransportCode); ok {
+		return tc.HTTPStatus()
+	}
+	return 0
+}
+
+// GRPCStatus returns the raw gRPC status code (a google.golang.org/grpc/codes.Code
+// value) c maps to, or codes.OK if c does not implement TransportCode or was
+// registered without one. See (TransportCode).GRPCStatus, in transport.go,
+// for the *status.Status form used to actually return a gRPC error.
+func GRPCStatus(c Code) codes.Code {
+	if tc, ok := c.(TransportCode); ok {
+		return tc.GRPCStatus().Code()
+	}
+	return codes.OK
+}
+
+// FromHTTPStatus returns the code registered under httpStatus in the
+// default registry (directly, or via RegisterMapping), or CodeNil if none is.
+func FromHTTPStatus(httpStatus int) Code {
+	c, ok := DefaultRegistry.LookupByHTTPStatus(httpStatus)
+	if !ok {
+		return CodeNil
+	}
+	return c
+}
+
+// CodeSeverity returns c's Severity, or SeverityUnspecified if c does not
+// implement SeverityCoder.
+func CodeSeverity(c Code) Severity {
+	if sc, ok := c.(SeverityCoder); ok {
+		return sc.Severity()
+	}
+	return SeverityUnspecified
+}
@@ -6,15 +6,19 @@
 package code
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
 // localCode represents an error code with associated message and detail.
 // It is designed for internal usage only.
 type localCode struct {
-	code    int         // Error code, usually represented as an integer.
-	message string      // Brief message describing the error code.
-	detail  interface{} // Additional details or context for the error code.
+	code       int         // Error code, usually represented as an integer.
+	message    string      // Brief message describing the error code.
+	detail     interface{} // Additional details or context for the error code.
+	httpStatus int         // Transport HTTP status to map this code to, 0 if unmapped.
+	grpcStatus int         // Transport gRPC status (codes.Code) to map this code to, 0 (OK) if unmapped.
+	severity   Severity    // How serious this code's condition is, SeverityUnspecified if unset.
 }
 
 // Code returns the integer representation of the error code.
@@ -33,6 +37,25 @@ func (c localCode) Detail() interface{} {
 	return c.detail
 }
 
+// HTTPStatus returns the HTTP status this code maps to, or 0 if it was
+// registered without one.
+func (c localCode) HTTPStatus() int {
+	return c.httpStatus
+}
+
+// GRPCCode returns the raw gRPC status (a google.golang.org/grpc/codes.Code
+// value) this code maps to, or 0 (OK) if it was registered without one. See
+// GRPCStatus, in transport.go, for the *status.Status form.
+func (c localCode) GRPCCode() int {
+	return c.grpcStatus
+}
+
+// Severity returns the severity this code was registered with, or
+// SeverityUnspecified if it was registered without one.
+func (c localCode) Severity() Severity {
+	return c.severity
+}
+
 // String formats and returns the error code, message, and detail as a string.
 func (c localCode) String() string {
 	if c.detail != nil {
@@ -43,3 +66,41 @@ func (c localCode) String() string {
 	}
 	return fmt.Sprintf(`%d`, c.code)
 }
+
+// localCodeJSON is the wire representation used by MarshalJSON/UnmarshalJSON.
+// Detail is intentionally omitted: it is typically request-local context, not
+// something a receiving service should reconstruct from the wire.
+type localCodeJSON struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+	GRPCStatus int    `json:"grpcStatus,omitempty"`
+	Severity   int    `json:"severity,omitempty"`
+}
+
+// MarshalJSON encodes the code so it can be carried in an API response.
+func (c localCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(localCodeJSON{
+		Code:       c.code,
+		Message:    c.message,
+		HTTPStatus: c.httpStatus,
+		GRPCStatus: c.grpcStatus,
+		Severity:   int(c.severity),
+	})
+}
+
+// UnmarshalJSON decodes a code previously written by MarshalJSON. The result
+// is a standalone localCode; use Lookup to resolve it against a Registry if
+// the receiving side needs the registered instance (and its Detail).
+func (c *localCode) UnmarshalJSON(data []byte) error {
+	var v localCodeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	c.code = v.Code
+	c.message = v.Message
+	c.httpStatus = v.HTTPStatus
+	c.grpcStatus = v.GRPCStatus
+	c.severity = Severity(v.Severity)
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package code provides structures and utilities for managing error codes.
+package code
+
+// Severity classifies how serious a Code's condition is, for callers that
+// want to triage or alert differently (log at a different level, skip
+// paging, ...) without switching on the numeric code.
+type Severity int
+
+// Severity levels, from least to most serious.
+const (
+	SeverityUnspecified Severity = iota
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+// String returns a human-readable name for s.
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "unspecified"
+	}
+}
+
+// SeverityCoder is implemented by Code values that carry a Severity.
+// localCode implements it.
+type SeverityCoder interface {
+	Code
+	Severity() Severity
+}
+
+// Option configures a Code built by New or WithCode, for attaching transport
+// mappings and a severity once at definition time instead of switching on
+// the code's number at every call site that needs them.
+type Option func(*localCode)
+
+// WithHTTPStatus sets the HTTP status a Code maps to.
+func WithHTTPStatus(httpStatus int) Option {
+	return func(c *localCode) { c.httpStatus = httpStatus }
+}
+
+// WithGRPCStatus sets the gRPC status (a google.golang.org/grpc/codes.Code
+// value) a Code maps to.
+func WithGRPCStatus(grpcStatus int) Option {
+	return func(c *localCode) { c.grpcStatus = grpcStatus }
+}
+
+// WithSeverity sets the Severity a Code reports.
+func WithSeverity(severity Severity) Option {
+	return func(c *localCode) { c.severity = severity }
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package code provides structures and utilities for managing error codes.
+package code
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry holds a set of Code values keyed by their integer code, so
+// domain-specific codes can be registered once and looked up by integer
+// instead of every downstream service reinventing its own code table.
+//
+// The zero value is not usable; create one with NewRegistry.
+type Registry struct {
+	mu           sync.RWMutex
+	byCode       map[int]Code
+	byHTTPStatus map[int]Code
+	order        []int // insertion order of codes, for Range.
+}
+
+// DefaultRegistry is the package-level registry. All codes defined in this
+// package (CodeOK, CodeNotFound, ...) are registered into it by init.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byCode:       make(map[int]Code),
+		byHTTPStatus: make(map[int]Code),
+	}
+}
+
+// Register adds c to the registry. opts (e.g. WithHTTPStatus, WithGRPCStatus,
+// WithSeverity) are applied to c first, the same way they are for New and
+// WithCode, so an application can register a plain Code built elsewhere and
+// attach its transport mapping in the same call; opts are silently ignored
+// if c is not a localCode (e.g. a caller's own Code implementation).
+//
+// Register returns an error if a different code is already registered under
+// the same integer value. If c implements TransportCode with a non-zero
+// HTTPStatus, it also becomes the code FromHTTPStatus returns for that status.
+func (r *Registry) Register(c Code, opts ...Option) error {
+	if lc, ok := c.(localCode); ok {
+		for _, opt := range opts {
+			opt(&lc)
+		}
+		c = lc
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.byCode[c.Code()]; ok {
+		return fmt.Errorf("code: code %d already registered with message %q", c.Code(), existing.Message())
+	}
+	r.byCode[c.Code()] = c
+	r.order = append(r.order, c.Code())
+	if tc, ok := c.(TransportCode); ok {
+		if httpStatus := tc.HTTPStatus(); httpStatus != 0 {
+			r.byHTTPStatus[httpStatus] = c
+		}
+	}
+	return nil
+}
+
+// MustRegister is like Register but panics if registration fails.
+func (r *Registry) MustRegister(c Code) {
+	if err := r.Register(c); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the code registered under the given integer, if any.
+func (r *Registry) Lookup(n int) (Code, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.byCode[n]
+	return c, ok
+}
+
+// LookupByHTTPStatus returns the code registered (directly, or via
+// RegisterMapping) under the given HTTP status, if any.
+func (r *Registry) LookupByHTTPStatus(httpStatus int) (Code, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.byHTTPStatus[httpStatus]
+	return c, ok
+}
+
+// Range calls f for every registered code in the order it was registered,
+// stopping early if f returns false.
+func (r *Registry) Range(f func(Code) bool) {
+	r.mu.RLock()
+	order := make([]int, len(r.order))
+	copy(order, r.order)
+	r.mu.RUnlock()
+
+	for _, n := range order {
+		c, ok := r.Lookup(n)
+		if !ok {
+			continue
+		}
+		if !f(c) {
+			return
+		}
+	}
+}
+
+// RegisterMapping attaches an HTTP and gRPC status mapping to the code
+// already registered under codeInt, replacing whatever mapping (if any) it
+// had. It returns an error if no code is registered under codeInt.
+//
+// This only updates r's own stored copy; it does not mutate whatever Code
+// value the caller originally registered. A Code that needs its mapping
+// visible wherever it's used directly, not just via Lookup, should instead
+// be constructed with NewWithHTTPStatus/NewWithGRPCStatus or have the
+// mapping baked into its own declaration, the way the codes in code.go are.
+func (r *Registry) RegisterMapping(codeInt, httpStatus, grpcStatus int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.byCode[codeInt]
+	if !ok {
+		return fmt.Errorf("code: no code registered for %d", codeInt)
+	}
+	updated := localCode{
+		code:       codeInt,
+		message:    existing.Message(),
+		detail:     existing.Detail(),
+		httpStatus: httpStatus,
+		grpcStatus: grpcStatus,
+	}
+	r.byCode[codeInt] = updated
+	if httpStatus != 0 {
+		r.byHTTPStatus[httpStatus] = updated
+	}
+	return nil
+}
+
+// Register adds c to the default registry. See (*Registry).Register.
+func Register(c Code, opts ...Option) error {
+	return DefaultRegistry.Register(c, opts...)
+}
+
+// MustRegister adds c to the default registry, panicking on failure.
+func MustRegister(c Code) {
+	DefaultRegistry.MustRegister(c)
+}
+
+// Lookup returns the code registered under n in the default registry.
+func Lookup(n int) (Code, bool) {
+	return DefaultRegistry.Lookup(n)
+}
+
+// RegisterMapping attaches an HTTP and gRPC status mapping to the code
+// already registered under codeInt in the default registry. See
+// (*Registry).RegisterMapping.
+func RegisterMapping(codeInt, httpStatus, grpcStatus int) error {
+	return DefaultRegistry.RegisterMapping(codeInt, httpStatus, grpcStatus)
+}
+
+// Range iterates the default registry in registration order.
+func Range(f func(Code) bool) {
+	DefaultRegistry.Range(f)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package code provides structures and utilities for managing error codes.
+package code
+
+import (
+	"encoding/json"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TransportCode is implemented by Code values that know how to describe
+// themselves in terms of HTTP and gRPC transport status, so a caller can
+// bridge a Code onto either transport without switching on its integer value.
+// localCode implements it; codes built from NewWithHTTPStatus,
+// NewWithGRPCStatus, or WithCode of such a code carry the same mappings.
+type TransportCode interface {
+	Code
+	HTTPStatus() int
+	GRPCStatus() *status.Status
+	MarshalProto() (*spb.Status, error)
+}
+
+// GRPCStatus returns the gRPC status this code maps to, implementing the
+// `GRPCStatus() *status.Status` convention google.golang.org/grpc/status
+// recognises, so a Code can be returned wherever a gRPC handler expects an
+// error and have status.Convert/status.FromError see the mapped code and
+// message. Codes registered without a gRPC mapping report codes.Unknown
+// alongside their own message.
+func (c localCode) GRPCStatus() *status.Status {
+	return status.New(codes.Code(c.grpcStatus), c.message)
+}
+
+// protoPayload is the JSON form of a Code's own fields, packed into a
+// google.rpc.Status message's Message field by MarshalProto/UnmarshalProto
+// so the framework-specific numeric code survives the round trip alongside
+// the gRPC status the Message field would otherwise hold alone.
+type protoPayload struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// MarshalProto encodes c as a google.rpc.Status message, suitable for
+// attaching to a gRPC response's error details or decoding on the client.
+func (c localCode) MarshalProto() (*spb.Status, error) {
+	payload, err := json.Marshal(protoPayload{Code: c.code, Message: c.message})
+	if err != nil {
+		return nil, err
+	}
+	return &spb.Status{
+		Code:    int32(c.grpcStatus),
+		Message: string(payload),
+	}, nil
+}
+
+// UnmarshalProto decodes a google.rpc.Status message into a Code. A message
+// produced by MarshalProto round-trips exactly; a Status from any other gRPC
+// source is decoded as a plain code using its gRPC status for both Code and
+// the gRPC mapping, and its Message verbatim.
+func UnmarshalProto(s *spb.Status) (Code, error) {
+	var payload protoPayload
+	if err := json.Unmarshal([]byte(s.GetMessage()), &payload); err == nil {
+		return localCode{
+			code:       payload.Code,
+			message:    payload.Message,
+			grpcStatus: int(s.GetCode()),
+		}, nil
+	}
+	return localCode{
+		code:       int(s.GetCode()),
+		message:    s.GetMessage(),
+		grpcStatus: int(s.GetCode()),
+	}, nil
+}
@@ -15,10 +15,11 @@ import (
 
 // Error represents a custom error with additional features.
 type Error struct {
-	error error     // Wrapped error.
-	stack stack     // Stack array, records stack trace information when error is created.
-	text  string    // Custom error message, may be empty if a code is provided.
-	code  code.Code // Associated error code.
+	error  error                  // Wrapped error.
+	stack  stack                  // Stack array, records stack trace information when error is created.
+	text   string                 // Custom error message, may be empty if a code is provided.
+	code   code.Code              // Associated error code.
+	fields map[string]interface{} // Arbitrary caller context attached via WithFields.
 }
 
 const (
@@ -88,10 +89,11 @@ func (err *Error) Current() error {
 		return nil
 	}
 	return &Error{
-		error: nil,
-		stack: err.stack,
-		text:  err.text,
-		code:  err.code,
+		error:  nil,
+		stack:  err.stack,
+		text:   err.text,
+		code:   err.code,
+		fields: err.fields,
 	}
 }
 
@@ -103,3 +105,44 @@ func (err *Error) Unwrap() error {
 	}
 	return err.error
 }
+
+// Is reports whether target is a code sentinel matching err's own Code,
+// letting callers write errors.Is(err, errors.NewCode(code.CodeNotFound))
+// to test for a business code anywhere in the chain instead of unwrapping
+// and switching on errors.Code(err) by hand. A code sentinel is an *Error
+// carrying only a Code: no text and no wrapped error, as produced by
+// NewCode/NewCodef/NewCodeSkip/NewCodeSkipf.
+//
+// For anything else, Is defers to the standard library's identity and
+// Unwrap-chain comparisons by returning false.
+func (err *Error) Is(target error) bool {
+	if err == nil || target == nil {
+		return false
+	}
+	t, ok := target.(*Error)
+	if !ok || t.error != nil || t.text != "" || t.code == nil || t.code == code.CodeNil {
+		return false
+	}
+	return err.code == t.code
+}
+
+// As implements the optional method the standard library's errors.As looks
+// for on each link of the chain, letting a caller pull err's code.Code out
+// with errors.As(err, &c) for a `var c code.Code` the same way errors.As
+// already extracts a *Error itself via the usual type assertion. For any
+// other target type, As defers to errors.As's default assignability check
+// by returning false.
+func (err *Error) As(target interface{}) bool {
+	if err == nil || target == nil {
+		return false
+	}
+	c, ok := target.(*code.Code)
+	if !ok {
+		return false
+	}
+	if got := err.Code(); got != code.CodeNil {
+		*c = got
+		return true
+	}
+	return false
+}
@@ -6,6 +6,9 @@
 package errors
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/focela/loom/pkg/errors/code"
 )
 
@@ -28,3 +31,49 @@ func (err *Error) SetCode(c code.Code) {
 	}
 	err.code = c
 }
+
+// HTTPStatus returns the HTTP status of err's code, or 0 if err carries no
+// code or its code has no HTTP mapping (see code.TransportCode).
+func (err *Error) HTTPStatus() int {
+	if tc, ok := err.Code().(code.TransportCode); ok {
+		return tc.HTTPStatus()
+	}
+	return 0
+}
+
+// GRPCStatus returns the gRPC status of err's code, implementing the
+// `GRPCStatus() *status.Status` convention google.golang.org/grpc/status
+// recognises, so an *Error can be returned directly where a gRPC handler
+// expects an error and have status.Convert/status.FromError see the mapped
+// code and message. An err whose code has no gRPC mapping (or that carries
+// no code at all) reports codes.Unknown alongside err's own message.
+func (err *Error) GRPCStatus() *status.Status {
+	if tc, ok := err.Code().(code.TransportCode); ok {
+		return tc.GRPCStatus()
+	}
+	return status.New(codes.Unknown, err.Error())
+}
+
+// HTTPStatus returns the HTTP status of err's code, walking err's wrap chain
+// the same way Code does, or 0 if err is nil, carries no code anywhere in
+// the chain, or the code it carries has no HTTP mapping.
+func HTTPStatus(err error) int {
+	if tc, ok := Code(err).(code.TransportCode); ok {
+		return tc.HTTPStatus()
+	}
+	return 0
+}
+
+// GRPCStatus returns the gRPC status of err's code, walking err's wrap chain
+// the same way Code does. An err that carries no code anywhere in the chain
+// (or whose code has no gRPC mapping) reports codes.Unknown alongside err's
+// own message, matching (*Error).GRPCStatus.
+func GRPCStatus(err error) *status.Status {
+	if tc, ok := Code(err).(code.TransportCode); ok {
+		return tc.GRPCStatus()
+	}
+	if err == nil {
+		return status.New(codes.Unknown, "")
+	}
+	return status.New(codes.Unknown, err.Error())
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+// WithFields returns a copy of err with the given fields merged into any
+// fields already attached. The fields travel along with err through further
+// Wrap calls, since each wrapping Error keeps its own cause by reference, and
+// appear in the output of Frames, MarshalJSON, and the %j Format verb.
+func (err *Error) WithFields(fields map[string]interface{}) *Error {
+	if err == nil {
+		return nil
+	}
+
+	merged := make(map[string]interface{}, len(err.fields)+len(fields))
+	for k, v := range err.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return &Error{
+		error:  err.error,
+		stack:  err.stack,
+		text:   err.text,
+		code:   err.code,
+		fields: merged,
+	}
+}
+
+// Fields returns the arbitrary context attached to err via WithFields.
+// It returns nil if no fields were attached.
+func (err *Error) Fields() map[string]interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.fields
+}
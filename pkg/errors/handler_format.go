@@ -17,11 +17,13 @@ import (
 // %-v, %-s : Print only the current error message.
 // %+s      : Print the full stack trace.
 // %+v      : Print the error string along with the full stack trace.
+// %j       : Print the structured JSON chain, as produced by MarshalJSON.
 //
 // Usage Examples:
 // fmt.Sprintf("%v", err)   -> Full error message
 // fmt.Sprintf("%-v", err)  -> Current error message
 // fmt.Sprintf("%+v", err)  -> Error + stack trace
+// fmt.Sprintf("%j", err)   -> Structured JSON chain
 func (err *Error) Format(state fmt.State, verb rune) {
 	switch verb {
 	case 's', 'v': // Handle %s and %v verbs.
@@ -45,5 +47,13 @@ func (err *Error) Format(state fmt.State, verb rune) {
 
 		// Default: %s, %v without flags.
 		_, _ = io.WriteString(state, err.Error())
+
+	case 'j': // %j: Structured JSON chain, the same data MarshalJSON produces.
+		data, marshalErr := err.MarshalJSON()
+		if marshalErr != nil {
+			_, _ = io.WriteString(state, marshalErr.Error())
+			return
+		}
+		_, _ = state.Write(data)
 	}
 }
@@ -9,10 +9,56 @@ import (
 	"encoding/json"
 )
 
-// MarshalJSON serializes the error into a JSON string representation.
-// It implements the `json.Marshaler` interface to ensure compatibility with `json.Marshal`.
-//
-// The error message is wrapped in double quotes as a standard JSON string.
-func (err Error) MarshalJSON() ([]byte, error) {
-	return json.Marshal(err.Error())
+// jsonFrame is the structured, JSON-serializable representation of a single
+// link in an Error's cause chain, as emitted by MarshalJSON and the %j
+// Format verb. Cause links to the next jsonFrame in the chain so the whole
+// chain round-trips through a single json.Marshal call instead of a flat array.
+type jsonFrame struct {
+	Index    int                    `json:"index"`
+	Message  string                 `json:"message"`
+	Code     int                    `json:"code,omitempty"`
+	File     string                 `json:"file,omitempty"`
+	Line     int                    `json:"line,omitempty"`
+	Function string                 `json:"function,omitempty"`
+	Fields   map[string]interface{} `json:"fields,omitempty"`
+	Cause    *jsonFrame             `json:"cause,omitempty"`
+}
+
+// toJSONFrame builds the structured chain representation rooted at err,
+// reusing Frames for the file/line/function of each link's own call site and
+// recursing into the wrapped error to populate Cause.
+func (err *Error) toJSONFrame(index int) *jsonFrame {
+	frame := &jsonFrame{
+		Index:   index,
+		Message: err.Error(),
+		Fields:  err.fields,
+	}
+	if c := err.code; c != nil && c.Code() >= 0 {
+		frame.Code = c.Code()
+	}
+	if lines := err.Frames(); len(lines) > 0 {
+		frame.File = lines[0].File
+		frame.Line = lines[0].Line
+		frame.Function = lines[0].Function
+	}
+
+	switch cause := err.error.(type) {
+	case nil:
+	case *Error:
+		frame.Cause = cause.toJSONFrame(index + 1)
+	default:
+		frame.Cause = &jsonFrame{Index: index + 1, Message: cause.Error()}
+	}
+	return frame
+}
+
+// MarshalJSON serializes the full error chain as structured data: each link
+// carries its index, message, code, call site, attached fields, and a cause
+// pointer to the next link, rather than the flattened human-readable string
+// Stack returns. It implements the `json.Marshaler` interface.
+func (err *Error) MarshalJSON() ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(err.toJSONFrame(1))
 }
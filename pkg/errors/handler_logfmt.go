@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+// ObjectEncoder is the subset of zap's zapcore.ObjectEncoder that
+// MarshalLogObject needs, defined locally so this package carries no zap
+// dependency. Because Go requires a method's parameter types to match
+// exactly for interface satisfaction, *Error does not implement zap's
+// zapcore.ObjectMarshaler directly; a caller wiring this into zap adapts
+// with a one-line shim, since zapcore.ObjectEncoder already satisfies this
+// interface structurally:
+//
+//	func (e *wrapped) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+//		return e.err.MarshalLogObject(enc)
+//	}
+type ObjectEncoder interface {
+	AddInt(key string, value int)
+	AddString(key, value string)
+	AddReflected(key string, value interface{}) error
+}
+
+// MarshalLogObject writes err's code, message, cause, and stack frames into
+// enc, implementing the MarshalLogObject(ObjectEncoder) error convention
+// zap's zapcore.ObjectMarshaler expects. The cause is written one level deep
+// as its own message, not recursively, since enc has no way to open a nested
+// object without already being a real zapcore.ObjectEncoder; callers that
+// want the full chain should range over MarshalJSON's output instead.
+func (err *Error) MarshalLogObject(enc ObjectEncoder) error {
+	if err == nil {
+		return nil
+	}
+	if c := err.code; c != nil && c.Code() >= 0 {
+		enc.AddInt("code", c.Code())
+	}
+	enc.AddString("message", err.Error())
+	if err.error != nil {
+		enc.AddString("cause", err.error.Error())
+	}
+	if frames := err.Frames(); len(frames) > 0 {
+		if encErr := enc.AddReflected("stack", frames); encErr != nil {
+			return encErr
+		}
+	}
+	return nil
+}
@@ -10,10 +10,7 @@ import (
 	"container/list"
 	"fmt"
 	"runtime"
-	"strings"
-
-	"github.com/focela/loom/internal/config"
-	"github.com/focela/loom/internal/core"
+	"sync"
 )
 
 // stackInfo manages stack info of certain error.
@@ -26,20 +23,58 @@ type stackInfo struct {
 // stackLine manages each line info of stack.
 type stackLine struct {
 	Function string // Function name, including full package path.
-	FileLine string // File name and line number.
+	File     string // Source file path.
+	Line     int    // Line number within File.
+}
+
+// FileLine returns the "file:line" representation of the stack line, as
+// rendered by the default stack formatter.
+func (l *stackLine) FileLine() string {
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// StackFrame describes a single call-stack frame, as a typed alternative to
+// the preformatted text Stack returns. It is the element type returned by
+// Error.Frames, passed to a formatter registered with SetStackFormatter, and
+// embedded in the structured output of MarshalJSON and the %j Format verb.
+type StackFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
 }
 
-// Stack returns the error stack trace as a string.
+// Frames returns err's own captured call stack (not including any wrapped
+// cause) as a typed slice, with the registered StackFilters applied. It
+// returns nil if err is nil or carries no stack.
+func (err *Error) Frames() []StackFrame {
+	if err == nil || err.stack == nil {
+		return nil
+	}
+
+	info := &stackInfo{}
+	loopLinesOfStackInfo(err.stack, info, true)
+	if info.Lines == nil {
+		return nil
+	}
+	return linesToFrames(info.Lines)
+}
+
+// Stack returns the error stack trace as a string, with the registered
+// StackFilters applied. It is equivalent to StackWith(StackModeBrief).
 func (err *Error) Stack() string {
 	if err == nil {
 		return ""
 	}
+	return buildStackText(err, true)
+}
 
+// buildStackText walks err's cause chain and renders it the way Stack does,
+// applying the registered StackFilters to every frame only if applyFilters is set.
+func buildStackText(err *Error, applyFilters bool) string {
 	var (
-		loop             = err
-		index            = 1
-		infos            []*stackInfo
-		isStackModeBrief = core.IsStackModeBrief()
+		loop  = err
+		index = 1
+		infos []*stackInfo
 	)
 
 	for loop != nil {
@@ -49,7 +84,7 @@ func (err *Error) Stack() string {
 		}
 		index++
 		infos = append(infos, info)
-		loopLinesOfStackInfo(loop.stack, info, isStackModeBrief)
+		loopLinesOfStackInfo(loop.stack, info, applyFilters)
 
 		if nestedErr, ok := loop.error.(*Error); ok {
 			loop = nestedErr
@@ -69,7 +104,8 @@ func (err *Error) Stack() string {
 }
 
 // loopLinesOfStackInfo iterates through stack info lines and extracts stack trace details.
-func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
+// When applyFilters is true, frames excluded by any registered StackFilter are skipped.
+func loopLinesOfStackInfo(st stack, info *stackInfo, applyFilters bool) {
 	if st == nil {
 		return
 	}
@@ -78,14 +114,7 @@ func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
 		if fn := runtime.FuncForPC(p - 1); fn != nil {
 			file, line := fn.FileLine(p - 1)
 
-			// Skip stack paths based on configuration
-			if isStackModeBrief && strings.Contains(file, config.StackFilterKeyForLoom) {
-				continue
-			}
-			if strings.Contains(file, stackFilterKeyLocal) || strings.Contains(file, "<") {
-				continue
-			}
-			if goRootForFilter != "" && strings.HasPrefix(file, goRootForFilter) {
+			if applyFilters && frameExcluded(runtime.Frame{Function: fn.Name(), File: file, Line: line}) {
 				continue
 			}
 
@@ -95,7 +124,8 @@ func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
 			}
 			info.Lines.PushBack(&stackLine{
 				Function: fn.Name(),
-				FileLine: fmt.Sprintf(`%s:%d`, file, line),
+				File:     file,
+				Line:     line,
 			})
 		}
 	}
@@ -114,10 +144,10 @@ func filterLinesOfStackInfos(infos []*stackInfo) {
 		var removes []*list.Element
 		for e := info.Lines.Front(); e != nil; e = e.Next() {
 			line := e.Value.(*stackLine)
-			if _, exists := set[line.FileLine]; exists {
+			if _, exists := set[line.FileLine()]; exists {
 				removes = append(removes, e)
 			} else {
-				set[line.FileLine] = struct{}{}
+				set[line.FileLine()] = struct{}{}
 			}
 		}
 
@@ -128,32 +158,73 @@ func filterLinesOfStackInfos(infos []*stackInfo) {
 	}
 }
 
-// formatStackInfos formats the error stack trace into a readable string.
+// formatStackInfos formats the error stack trace into a readable string,
+// rendering each cause's frames with the registered stack formatter.
 func formatStackInfos(infos []*stackInfo) string {
 	buffer := bytes.NewBuffer(nil)
+	formatter := currentStackFormatter()
 
 	for i, info := range infos {
 		buffer.WriteString(fmt.Sprintf("%d. %s\n", i+1, info.Message))
 		if info.Lines != nil && info.Lines.Len() > 0 {
-			formatStackLines(buffer, info.Lines)
+			buffer.WriteString(formatter(linesToFrames(info.Lines)))
 		}
 	}
 	return buffer.String()
 }
 
-// formatStackLines formats and appends stack lines to the buffer.
-func formatStackLines(buffer *bytes.Buffer, lines *list.List) {
-	space := "  "
-	for i, e := 0, lines.Front(); e != nil; i, e = i+1, e.Next() {
+// linesToFrames converts a stackInfo's internal line list to the public
+// StackFrame slice type.
+func linesToFrames(lines *list.List) []StackFrame {
+	frames := make([]StackFrame, 0, lines.Len())
+	for e := lines.Front(); e != nil; e = e.Next() {
 		line := e.Value.(*stackLine)
+		frames = append(frames, StackFrame{
+			Function: line.Function,
+			File:     line.File,
+			Line:     line.Line,
+		})
+	}
+	return frames
+}
 
+// defaultStackFormatter reproduces Stack's original rendering: each frame
+// numbered and indented, with the function name followed by its file:line.
+func defaultStackFormatter(frames []StackFrame) string {
+	buffer := bytes.NewBuffer(nil)
+	space := "  "
+	for i, frame := range frames {
 		if i >= 9 {
 			space = " "
 		}
-
 		buffer.WriteString(fmt.Sprintf(
-			"   %d).%s%s\n        %s\n",
-			i+1, space, line.Function, line.FileLine,
+			"   %d).%s%s\n        %s:%d\n",
+			i+1, space, frame.Function, frame.File, frame.Line,
 		))
 	}
+	return buffer.String()
+}
+
+var (
+	stackFormatterMu sync.RWMutex
+	stackFormatter   func([]StackFrame) string = defaultStackFormatter
+)
+
+// SetStackFormatter overrides how each cause's captured frames are rendered
+// within Stack/StackWith's multi-line output. Pass nil to restore the
+// built-in renderer.
+func SetStackFormatter(formatter func([]StackFrame) string) {
+	stackFormatterMu.Lock()
+	defer stackFormatterMu.Unlock()
+	if formatter == nil {
+		formatter = defaultStackFormatter
+	}
+	stackFormatter = formatter
+}
+
+// currentStackFormatter returns the formatter currently configured via SetStackFormatter.
+func currentStackFormatter() func([]StackFrame) string {
+	stackFormatterMu.RLock()
+	defer stackFormatterMu.RUnlock()
+	return stackFormatter
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/focela/loom/internal/config"
+	"github.com/focela/loom/internal/core"
+)
+
+// StackFilter reports whether a captured call-stack frame should be excluded
+// from an Error's Stack, Frames, and StackWith output. A frame is excluded if
+// any registered filter returns true for it.
+type StackFilter func(frame runtime.Frame) bool
+
+var (
+	stackFiltersMu sync.RWMutex
+	stackFilters   = make(map[string]StackFilter)
+)
+
+func init() {
+	// These reproduce the three rules Stack applied unconditionally before
+	// the filter chain existed: skip this package's own frames in brief mode,
+	// skip the caller's local wrapper frames, and skip the Go runtime itself.
+	RegisterStackFilter("loom", func(frame runtime.Frame) bool {
+		return core.IsStackModeBrief() && strings.Contains(frame.File, config.StackFilterKeyForLoom)
+	})
+	RegisterStackFilter("local", func(frame runtime.Frame) bool {
+		return strings.Contains(frame.File, stackFilterKeyLocal)
+	})
+	RegisterStackFilter("goroot", func(frame runtime.Frame) bool {
+		return goRootForFilter != "" && strings.HasPrefix(frame.File, goRootForFilter)
+	})
+
+	// New defaults: vendored dependencies, generated test helpers, and a
+	// couple of common web frameworks whose frames rarely help diagnose the
+	// caller's own bug.
+	RegisterStackFilter("vendor", func(frame runtime.Frame) bool {
+		return strings.Contains(frame.File, "/vendor/")
+	})
+	RegisterStackFilter("test", func(frame runtime.Frame) bool {
+		return strings.HasSuffix(frame.File, "_test.go")
+	})
+	RegisterStackFilter("framework", func(frame runtime.Frame) bool {
+		for _, pkg := range []string{
+			"github.com/gin-gonic/gin",
+			"github.com/labstack/echo",
+			"net/http",
+		} {
+			if strings.Contains(frame.Function, pkg) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// RegisterStackFilter registers a named filter that excludes matching frames
+// from every Error's stack output from then on. Registering under a name
+// that's already in use replaces the existing filter - this is how the
+// built-in "loom", "local", "goroot", "vendor", "test", and "framework"
+// defaults can be overridden wholesale; to simply turn one off, call
+// DisableStackFilter(name) instead.
+func RegisterStackFilter(name string, filter StackFilter) {
+	stackFiltersMu.Lock()
+	defer stackFiltersMu.Unlock()
+	stackFilters[name] = filter
+}
+
+// DisableStackFilter removes a previously registered filter, whether a
+// built-in default or one added via RegisterStackFilter. It reports whether a
+// filter was registered under that name.
+func DisableStackFilter(name string) bool {
+	stackFiltersMu.Lock()
+	defer stackFiltersMu.Unlock()
+	if _, ok := stackFilters[name]; !ok {
+		return false
+	}
+	delete(stackFilters, name)
+	return true
+}
+
+// frameExcluded reports whether any registered filter excludes frame.
+func frameExcluded(frame runtime.Frame) bool {
+	stackFiltersMu.RLock()
+	defer stackFiltersMu.RUnlock()
+	for _, filter := range stackFilters {
+		if filter(frame) {
+			return true
+		}
+	}
+	return false
+}
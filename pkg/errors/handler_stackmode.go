@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StackMode selects how much of an Error's stack StackWith renders, as a
+// per-call alternative to Stack's fixed, filtered multi-line output.
+type StackMode int
+
+const (
+	// StackModeBrief renders the filtered, multi-line stack Stack returns by
+	// default: every registered StackFilter applied, one numbered frame per line.
+	StackModeBrief StackMode = iota
+
+	// StackModeFull renders every captured frame, bypassing all registered
+	// StackFilters. Useful for local debugging when the default filtering
+	// hides a frame you need.
+	StackModeFull
+
+	// StackModeOneLine renders the cause chain as a single line, one
+	// "index: message (file:line)" segment per link, joined by " <- ".
+	StackModeOneLine
+
+	// StackModeJSON renders the structured chain MarshalJSON produces, as a string.
+	StackModeJSON
+)
+
+// StackWith renders err's stack the way mode requests, independent of Stack's
+// fixed format. It returns an empty string if err is nil.
+func (err *Error) StackWith(mode StackMode) string {
+	if err == nil {
+		return ""
+	}
+
+	switch mode {
+	case StackModeFull:
+		return buildStackText(err, false)
+	case StackModeOneLine:
+		return buildOneLineStack(err)
+	case StackModeJSON:
+		data, marshalErr := err.MarshalJSON()
+		if marshalErr != nil {
+			return marshalErr.Error()
+		}
+		return string(data)
+	default:
+		return buildStackText(err, true)
+	}
+}
+
+// buildOneLineStack renders err's cause chain as a single compact line,
+// reusing Frames for each link's call site.
+func buildOneLineStack(err *Error) string {
+	var (
+		parts []string
+		loop  = err
+		index = 1
+	)
+
+	for loop != nil {
+		part := fmt.Sprintf("%d: %v", index, loop)
+		if frames := loop.Frames(); len(frames) > 0 {
+			part += fmt.Sprintf(" (%s:%d)", frames[0].File, frames[0].Line)
+		}
+		parts = append(parts, part)
+		index++
+
+		if nestedErr, ok := loop.error.(*Error); ok {
+			loop = nestedErr
+		} else {
+			if loop.error != nil {
+				parts = append(parts, fmt.Sprintf("%d: %s", index, loop.error.Error()))
+			}
+			break
+		}
+	}
+	return strings.Join(parts, " <- ")
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errors provides rich functionalities to manipulate errors.
+package errors
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/focela/loom/pkg/errors/code"
+)
+
+// Multi aggregates zero or more errors into a single error value. It
+// satisfies Equaler, Coder, Stacker, Causer, and Currenter like the rest of
+// this package's error types.
+//
+// Multi intentionally does not implement this package's single-error
+// Unwrapper interface (Unwrap() error) since it may hold more than one child
+// error; instead it implements Go's multi-error convention, Unwrap() []error,
+// so the standard library's errors.Is and errors.As traverse every child.
+type Multi struct {
+	mu          sync.RWMutex
+	errs        []error
+	codeReducer func([]error) code.Code
+}
+
+// NewMulti returns an empty *Multi ready to accumulate errors via Append.
+func NewMulti() *Multi {
+	return &Multi{}
+}
+
+// Join returns an error aggregating the non-nil errors in errs. It returns
+// nil if every input is nil, returns the single remaining error unwrapped
+// from its group when only one is non-nil, and flattens any nested *Multi
+// values so chains don't nest Multi-of-Multi.
+func Join(errs ...error) error {
+	m := NewMulti()
+	for _, err := range errs {
+		m.Append(err)
+	}
+	return m.ErrorOrNil()
+}
+
+// Combine is Join with a name that highlights its stack behavior: each
+// contributing error keeps its own stack (see (*Multi).Stack), so
+// IsStackModeBrief/StackModeDetail printing of the combined error still shows
+// every branch's outermost frames instead of collapsing them into one trace.
+func Combine(errs ...error) error {
+	return Join(errs...)
+}
+
+// Append adds err to the group. Nested *Multi values are flattened so
+// Errors() always returns a flat list; a nil err is ignored.
+func (m *Multi) Append(err error) {
+	if err == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if nested, ok := err.(*Multi); ok {
+		m.errs = append(m.errs, nested.Errors()...)
+		return
+	}
+	m.errs = append(m.errs, err)
+}
+
+// SetCodeReducer overrides how Code() picks a code.Code out of the group.
+// The default reducer returns the code of the first coded child.
+func (m *Multi) SetCodeReducer(reducer func([]error) code.Code) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.codeReducer = reducer
+}
+
+// Errors returns a copy of the accumulated child errors.
+func (m *Multi) Errors() []error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]error(nil), m.errs...)
+}
+
+// ErrorOrNil returns nil if the group has no child errors, the single child
+// error if it holds exactly one, or m itself otherwise.
+func (m *Multi) ErrorOrNil() error {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	switch len(m.errs) {
+	case 0:
+		return nil
+	case 1:
+		return m.errs[0]
+	default:
+		return m
+	}
+}
+
+// Error joins every child error's message with a comma separator.
+func (m *Multi) Error() string {
+	if m == nil {
+		return ""
+	}
+
+	errs := m.Errors()
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, commaSeparatorSpace)
+}
+
+// Equal reports whether target is a *Multi with the same number of child
+// errors, each pairwise equal per this package's Equal helper.
+func (m *Multi) Equal(target error) bool {
+	if m == nil {
+		return target == nil
+	}
+
+	other, ok := target.(*Multi)
+	if !ok {
+		return false
+	}
+
+	errs, otherErrs := m.Errors(), other.Errors()
+	if len(errs) != len(otherErrs) {
+		return false
+	}
+	for i := range errs {
+		if !Equal(errs[i], otherErrs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Code returns the code of the first coded child error, or the result of the
+// reducer set via SetCodeReducer when one is configured. It returns
+// code.CodeNil for an empty or uncoded group.
+func (m *Multi) Code() code.Code {
+	if m == nil {
+		return code.CodeNil
+	}
+
+	errs := m.Errors()
+
+	m.mu.RLock()
+	reducer := m.codeReducer
+	m.mu.RUnlock()
+	if reducer != nil {
+		return reducer(errs)
+	}
+
+	for _, err := range errs {
+		if c := Code(err); c != code.CodeNil {
+			return c
+		}
+	}
+	return code.CodeNil
+}
+
+// Stack concatenates every child error's stack trace, each preceded by a
+// header identifying its position in the group.
+func (m *Multi) Stack() string {
+	if m == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	for i, err := range m.Errors() {
+		b.WriteString("Error ")
+		b.WriteString(strconv.Itoa(i + 1))
+		b.WriteString(":\n")
+		b.WriteString(Stack(err))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Cause returns the root cause of the first child error.
+func (m *Multi) Cause() error {
+	if m == nil {
+		return nil
+	}
+	errs := m.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	return Cause(errs[0])
+}
+
+// Current returns a shallow copy of m holding the same child errors, mirroring
+// the behavior of Error.Current for the single-error type.
+func (m *Multi) Current() error {
+	if m == nil {
+		return nil
+	}
+	current := &Multi{errs: m.Errors()}
+	return current
+}
+
+// Unwrap returns every child error so the standard library's errors.Is and
+// errors.As (Go 1.20+) traverse the whole group.
+func (m *Multi) Unwrap() []error {
+	if m == nil {
+		return nil
+	}
+	return m.Errors()
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package errorscheck implements a go/analysis analyzer that flags common
+// misuses of github.com/focela/loom/pkg/errors:
+//
+//   - calls to the Wrap/WrapCode family whose result is discarded, or
+//     assigned over a variable whose previous value is never used;
+//   - err.Cause() chained straight into another call or type assertion,
+//     without an intermediate nil-check (Cause returns nil for a nil
+//     receiver, and callers frequently type-assert right after);
+//   - fmt.Errorf("%w", err) wrapping a *errors.Error, which silently drops
+//     its captured stack instead of preserving it via errors.Wrap;
+//   - errors.Error{} composite literals built outside the errors package,
+//     bypassing stack capture entirely.
+package errorscheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// errorsPkgPath is the import path of the package this analyzer protects.
+const errorsPkgPath = "github.com/focela/loom/pkg/errors"
+
+// wrapFuncNames lists the errors package functions that wrap an error and
+// capture a stack; discarding or overwriting their result loses both.
+var wrapFuncNames = map[string]bool{
+	"Wrap": true, "Wrapf": true, "WrapSkip": true, "WrapSkipf": true,
+	"WrapCode": true, "WrapCodef": true, "WrapCodeSkip": true, "WrapCodeSkipf": true,
+}
+
+const doc = `check for common misuses of github.com/focela/loom/pkg/errors
+
+errorscheck reports:
+  - Wrap/WrapCode results that are discarded or silently overwritten
+  - err.Cause() chained into another call without a nil-check
+  - fmt.Errorf("%w", err) wrapping a *errors.Error, dropping its stack
+  - errors.Error{} literals built outside the errors package`
+
+// Analyzer is the errorscheck go/analysis.Analyzer. It can be run via its
+// own binary (see cmd/errorscheck), folded into a larger multichecker, or
+// loaded as a golangci-lint plugin (see Plugin in this package).
+var Analyzer = &analysis.Analyzer{
+	Name:     "errorscheck",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	insp.Preorder([]ast.Node{(*ast.BlockStmt)(nil)}, func(n ast.Node) {
+		checkWrapUsage(pass, n.(*ast.BlockStmt))
+	})
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if push {
+			call := n.(*ast.CallExpr)
+			checkChainedCause(pass, call, stack)
+			checkErrorfW(pass, call)
+		}
+		return true
+	})
+	insp.Preorder([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		checkExternalErrorLiteral(pass, n.(*ast.CompositeLit))
+	})
+
+	return nil, nil
+}
+
+// checkWrapUsage walks a block's statements looking for two misuses of the
+// Wrap family: a call used as a bare statement (its result thrown away), and
+// an assignment to a variable that the very next statement overwrites before
+// it is ever read.
+func checkWrapUsage(pass *analysis.Pass, block *ast.BlockStmt) {
+	for i, stmt := range block.List {
+		switch s := stmt.(type) {
+		case *ast.ExprStmt:
+			if call, ok := s.X.(*ast.CallExpr); ok && isWrapCall(pass, call) {
+				pass.Report(analysis.Diagnostic{
+					Pos:     call.Pos(),
+					Message: "result of errors.Wrap-family call is discarded; the wrapped error (and its stack) is lost",
+				})
+			}
+
+		case *ast.AssignStmt:
+			target, call, ok := singleWrapAssign(pass, s)
+			if !ok || i+1 >= len(block.List) {
+				continue
+			}
+			if overwritesIdentUnread(pass, block.List[i+1], target) {
+				pass.Report(analysis.Diagnostic{
+					Pos: call.Pos(),
+					Message: fmt.Sprintf(
+						"result of errors.Wrap-family call assigned to %q is overwritten by the next statement before being used",
+						target.Name,
+					),
+				})
+			}
+		}
+	}
+}
+
+// singleWrapAssign reports whether assign is "target = WrapCall(...)" or
+// "target := WrapCall(...)" for a single identifier target.
+func singleWrapAssign(pass *analysis.Pass, assign *ast.AssignStmt) (*ast.Ident, *ast.CallExpr, bool) {
+	if (assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE) || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil, false
+	}
+	target, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || target.Name == "_" {
+		return nil, nil, false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || !isWrapCall(pass, call) {
+		return nil, nil, false
+	}
+	return target, call, true
+}
+
+// overwritesIdentUnread reports whether stmt assigns directly to ident
+// without reading ident anywhere on the right-hand side, the classic
+// dead-store pattern: `v = errors.Wrap(...)` followed by `v = somethingElse`.
+func overwritesIdentUnread(pass *analysis.Pass, stmt ast.Stmt, ident *ast.Ident) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 {
+		return false
+	}
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || lhs.Name != ident.Name {
+		return false
+	}
+
+	read := false
+	for _, rhs := range assign.Rhs {
+		ast.Inspect(rhs, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Name == ident.Name {
+				read = true
+				return false
+			}
+			return true
+		})
+	}
+	return !read
+}
+
+// isWrapCall reports whether call invokes one of wrapFuncNames in errorsPkgPath.
+func isWrapCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !wrapFuncNames[sel.Sel.Name] {
+		return false
+	}
+	return isErrorsPkgIdent(pass, sel.X)
+}
+
+// isErrorsPkgIdent reports whether expr is an identifier bound to an import
+// of errorsPkgPath.
+func isErrorsPkgIdent(pass *analysis.Pass, expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := pass.TypesInfo.Uses[ident].(*types.PkgName)
+	return ok && pkgName.Imported().Path() == errorsPkgPath
+}
+
+// checkChainedCause flags `x.Cause()` used directly as the receiver of a
+// further selector or type assertion, e.g. `err.Cause().(*errors.Error)` or
+// `err.Cause().Error()`, which skips the nil-check Cause's contract requires
+// since it returns nil for a nil *errors.Error.
+func checkChainedCause(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Cause" || len(call.Args) != 0 {
+		return
+	}
+	if !isErrorsErrorType(pass.TypesInfo.TypeOf(sel.X)) {
+		return
+	}
+	if !chainedWithoutNilCheck(call, stack) {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     call.Pos(),
+		Message: "err.Cause() returns nil for a nil *errors.Error; assign the result to a variable and check it before using it further",
+	})
+}
+
+// chainedWithoutNilCheck reports whether call (a Cause() call) is used
+// directly as the receiver of another selector or type assertion, rather
+// than being assigned to a variable first where it could be nil-checked.
+func chainedWithoutNilCheck(call *ast.CallExpr, stack []ast.Node) bool {
+	if len(stack) < 2 {
+		return false
+	}
+	switch parent := stack[len(stack)-2].(type) {
+	case *ast.SelectorExpr:
+		return parent.X == call
+	case *ast.TypeAssertExpr:
+		return parent.X == call
+	default:
+		return false
+	}
+}
+
+// isErrorsErrorType reports whether t is *errors.Error (or errors.Error).
+func isErrorsErrorType(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == errorsPkgPath && obj.Name() == "Error"
+}
+
+// checkErrorfW flags fmt.Errorf("...%w...", ..., err) where err's static
+// type is *errors.Error: %w silently drops the stack that errors.Wrap would
+// have preserved. When the call has exactly one format arg and the format
+// ends in "%w", a suggested fix rewrites it to errors.Wrap/errors.Wrapf.
+func checkErrorfW(pass *analysis.Pass, call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Errorf" {
+		return
+	}
+	if ident, ok := sel.X.(*ast.Ident); !ok || ident.Name != "fmt" {
+		return
+	}
+	if len(call.Args) < 2 {
+		return
+	}
+	format, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || format.Kind != token.STRING {
+		return
+	}
+	formatValue, err := stringLitValue(format)
+	if err != nil || !strings.Contains(formatValue, "%w") {
+		return
+	}
+
+	var wrapped ast.Expr
+	for _, arg := range call.Args[1:] {
+		if isErrorsErrorType(pass.TypesInfo.TypeOf(arg)) {
+			wrapped = arg
+			break
+		}
+	}
+	if wrapped == nil {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		Message: "fmt.Errorf(\"%w\", ...) wrapping a *errors.Error drops its stack; use errors.Wrap instead",
+	}
+	if len(call.Args) == 2 && strings.HasSuffix(formatValue, "%w") {
+		if fix, ok := errorfWFix(call, formatValue, wrapped); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+	}
+	pass.Report(diag)
+}
+
+// errorfWFix builds the replacement for the single-arg "...%w" case,
+// swapping fmt.Errorf for errors.Wrap with any leading format text preserved
+// as the wrap text. It only offers a fix when the wrapped expression is a
+// plain identifier, to avoid splicing a more complex expression incorrectly.
+func errorfWFix(call *ast.CallExpr, formatValue string, wrapped ast.Expr) (analysis.SuggestedFix, bool) {
+	wrappedSrc, ok := exprSource(wrapped)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	prefix := strings.TrimSuffix(formatValue, "%w")
+	prefix = strings.TrimRight(prefix, ": ")
+
+	replacement := fmt.Sprintf("errors.Wrap(%s)", wrappedSrc)
+	if prefix != "" {
+		replacement = fmt.Sprintf("errors.Wrap(%s, %q)", wrappedSrc, prefix)
+	}
+
+	return analysis.SuggestedFix{
+		Message: "replace fmt.Errorf with errors.Wrap to preserve the stack",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     call.Pos(),
+			End:     call.End(),
+			NewText: []byte(replacement),
+		}},
+	}, true
+}
+
+// exprSource returns a source-printable form of expr for splicing into a
+// suggested fix. Only plain identifiers are supported; anything more
+// complex is reported without a fix rather than risking an incorrect rewrite.
+func exprSource(expr ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// stringLitValue unquotes a Go string literal's source text to its value.
+func stringLitValue(lit *ast.BasicLit) (string, error) {
+	return strconv.Unquote(lit.Value)
+}
+
+// checkExternalErrorLiteral flags errors.Error{} composite literals built
+// outside the errors package, which bypass the stack capture every
+// exported constructor (New, Wrap, NewCode, ...) performs.
+func checkExternalErrorLiteral(pass *analysis.Pass, lit *ast.CompositeLit) {
+	if pass.Pkg.Path() == errorsPkgPath {
+		return
+	}
+	if !isErrorsErrorType(pass.TypesInfo.TypeOf(lit)) {
+		return
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		Message: "errors.Error{} built outside the errors package bypasses stack capture; use errors.New/Wrap/NewCode instead",
+	})
+}
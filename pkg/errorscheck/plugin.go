@@ -0,0 +1,35 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+package errorscheck
+
+import (
+	"github.com/golangci/plugin-module-register/register"
+	"golang.org/x/tools/go/analysis"
+)
+
+// init registers errorscheck as a golangci-lint module plugin under the name
+// "errorscheck", so a golangci-lint build with this package listed in its
+// .custom-gcl.yml can enable it like any built-in linter.
+func init() {
+	register.Plugin("errorscheck", newPlugin)
+}
+
+// newPlugin builds the plugin golangci-lint loads. It takes no settings.
+func newPlugin(_ any) (register.LinterPlugin, error) {
+	return plugin{}, nil
+}
+
+// plugin adapts Analyzer to golangci-lint's register.LinterPlugin interface.
+type plugin struct{}
+
+// BuildAnalyzers returns the analyzers this plugin contributes.
+func (plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{Analyzer}, nil
+}
+
+// GetLoadMode reports that this plugin needs full type information.
+func (plugin) GetLoadMode() string {
+	return register.LoadModeTypesInfo
+}
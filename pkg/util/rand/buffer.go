@@ -6,43 +6,200 @@
 package random
 
 import (
+	"context"
 	"crypto/rand"
-
-	"github.com/focela/loom/pkg/errors"
-	"github.com/focela/loom/pkg/errors/code"
+	"io"
+	"sync"
+	"time"
 )
 
 const (
-	// bufferChanSize defines the size of the random byte buffer channel.
+	// bufferChanSize defines the default size of the random byte buffer channel.
 	bufferChanSize = 10000
-	// bufferChunkSize defines the size of each random byte chunk.
+	// bufferChunkSize defines the default size of each random byte chunk read from Reader.
 	bufferChunkSize = 1024
-	// stepSize defines the step increment for slicing the random byte buffer.
+	// stepSize defines the default step increment for slicing the random byte buffer.
 	stepSize = 4
 )
 
-// bufferChan serves as a channel to store random byte chunks for high-performance access.
-var bufferChan = make(chan []byte, bufferChanSize)
+// maxBackoff caps the exponential backoff applied between retries after a
+// Reader error, so a persistently broken source still retries at a bounded rate.
+const maxBackoff = 30 * time.Second
+
+// Options configures a Source.
+type Options struct {
+	// Reader is the underlying entropy source. Defaults to crypto/rand.Reader.
+	// Tests can supply a deterministic reader instead.
+	Reader io.Reader
+	// BufferChanSize is the capacity of the produced-bytes channel. Defaults to bufferChanSize.
+	BufferChanSize int
+	// BufferChunkSize is how many bytes are read from Reader per iteration. Defaults to bufferChunkSize.
+	BufferChunkSize int
+	// StepSize is the slice width handed out per channel send. Defaults to stepSize.
+	StepSize int
+	// Context governs the life of the producer goroutine. Defaults to context.Background(),
+	// meaning the Source only stops via Close.
+	Context context.Context
+}
+
+// Source produces random bytes on a buffered channel via a background
+// goroutine, so callers avoid repeated expensive syscalls. Unlike a bare
+// package-level buffer, a Source can be closed, reseeded, and inspected for
+// errors, and multiple independent Sources may coexist (e.g. one per tenant).
+type Source struct {
+	out    chan []byte
+	chunk  int
+	step   int
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu     sync.Mutex
+	reader io.Reader
+	err    error
+}
+
+// NewSource creates and starts a Source with the given Options. Zero-valued
+// fields in opts fall back to the package defaults.
+func NewSource(opts Options) (*Source, error) {
+	chanSize := opts.BufferChanSize
+	if chanSize <= 0 {
+		chanSize = bufferChanSize
+	}
+	chunkSize := opts.BufferChunkSize
+	if chunkSize <= 0 {
+		chunkSize = bufferChunkSize
+	}
+	step := opts.StepSize
+	if step <= 0 {
+		step = stepSize
+	}
+	if step > chunkSize {
+		step = chunkSize
+	}
+	reader := opts.Reader
+	if reader == nil {
+		reader = rand.Reader
+	}
+	parent := opts.Context
+	if parent == nil {
+		parent = context.Background()
+	}
 
-// init starts a goroutine to buffer random bytes for high-performance random generation.
-func init() {
-	// Start asynchronous production of random bytes.
-	go produceRandomBufferBytesAsync()
+	ctx, cancel := context.WithCancel(parent)
+	s := &Source{
+		out:    make(chan []byte, chanSize),
+		chunk:  chunkSize,
+		step:   step,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		reader: reader,
+	}
+	go s.produce(ctx)
+	return s, nil
 }
 
-// produceRandomBufferBytesAsync continuously generates random bytes and stores them in bufferChan.
-// This approach avoids repeated expensive system calls to fetch random data.
-func produceRandomBufferBytesAsync() {
+// produce continuously reads random bytes from the configured reader and
+// distributes them in step-sized slices onto s.out, until ctx is canceled.
+// A Reader error is recorded (see Err) and retried with exponential backoff
+// instead of panicking, so a transient or permanently broken entropy source
+// degrades the Source rather than killing the process.
+func (s *Source) produce(ctx context.Context) {
+	defer close(s.done)
+
+	backoff := 10 * time.Millisecond
 	for {
-		buffer := make([]byte, bufferChunkSize)
-		n, err := rand.Read(buffer)
+		buffer := make([]byte, s.chunk)
+		n, err := io.ReadFull(s.currentReader(), buffer)
 		if err != nil {
-			panic(errors.WrapCode(code.CodeInternalError, err, "error reading random buffer from system"))
+			s.setErr(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			if backoff < maxBackoff {
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+			}
+			continue
 		}
+		backoff = 10 * time.Millisecond
+		s.setErr(nil)
 
-		// Efficiently distribute random bytes into bufferChan using defined step size.
-		for i := 0; i <= n-stepSize; i += stepSize {
-			bufferChan <- buffer[i : i+stepSize]
+		for i := 0; i <= n-s.step; i += s.step {
+			select {
+			case s.out <- buffer[i : i+s.step]:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}
 }
+
+// Bytes returns n random bytes drawn from the Source's buffer.
+func (s *Source) Bytes(n int) []byte {
+	if n <= 0 {
+		return nil
+	}
+	b := make([]byte, n)
+	for i := 0; i < n; i += s.step {
+		copy(b[i:], <-s.out)
+	}
+	return b
+}
+
+// Uint64 returns a random uint64 drawn from the Source's buffer.
+func (s *Source) Uint64() uint64 {
+	b := s.Bytes(8)
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v |= uint64(b[i]) << (8 * i)
+	}
+	return v
+}
+
+// Reseed swaps in a new entropy Reader, taking effect on the producer
+// goroutine's next read. It lets tests inject a deterministic reader without
+// recreating the Source.
+func (s *Source) Reseed(r io.Reader) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reader = r
+}
+
+// currentReader returns the Reader currently in effect, honoring Reseed.
+func (s *Source) currentReader() io.Reader {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reader
+}
+
+// Err returns the most recent error encountered reading from the Source's
+// entropy Reader, or nil if the last read succeeded.
+func (s *Source) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *Source) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+// Close stops the producer goroutine and waits for it to exit.
+func (s *Source) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// defaultSource backs the package-level functions (Intn, B, S, ...).
+var defaultSource, _ = NewSource(Options{})
+
+// bufferChan serves as a channel to store random byte chunks for high-performance access.
+// It is a thin wrapper over defaultSource for backward compatibility.
+var bufferChan = defaultSource.out
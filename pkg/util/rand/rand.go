@@ -18,10 +18,15 @@ const (
 )
 
 // Intn returns a random integer in the range [0, max).
+// When SetSecureMode(true) is in effect, this routes through crypto/rand
+// with rejection sampling (see SecureIntn) instead of the fast bufferChan PRNG.
 func Intn(max int) int {
 	if max <= 0 {
 		return max
 	}
+	if secureMode.Load() {
+		return SecureIntn(max)
+	}
 	n := int(binary.LittleEndian.Uint32(<-bufferChan)) % max
 	if n < 0 {
 		return -n
@@ -84,6 +89,9 @@ func B(n int) []byte {
 	if n <= 0 {
 		return nil
 	}
+	if secureMode.Load() {
+		return SecureB(n)
+	}
 	b := make([]byte, n)
 	for i := 0; i < n; i += 4 {
 		copy(b[i:], <-bufferChan)
@@ -96,10 +104,15 @@ func B(n int) []byte {
 // --------------------
 
 // S generates a random string of length n, optionally including symbols.
+// When SetSecureMode(true) is in effect, characters are chosen via
+// rejection sampling (see SecureS) instead of a biased modulo reduction.
 func S(n int, useSymbols ...bool) string {
 	if n <= 0 {
 		return ""
 	}
+	if secureMode.Load() {
+		return SecureS(n, useSymbols...)
+	}
 	b := make([]byte, n)
 	numberBytes := B(n)
 	for i := range b {
@@ -133,10 +146,15 @@ func Str(s string, n int) string {
 }
 
 // Digits generates a random string of digits of length n.
+// When SetSecureMode(true) is in effect, digits are chosen via rejection
+// sampling (see SecureDigits) instead of a biased modulo reduction.
 func Digits(n int) string {
 	if n <= 0 {
 		return ""
 	}
+	if secureMode.Load() {
+		return SecureDigits(n)
+	}
 	b := make([]byte, n)
 	numberBytes := B(n)
 	for i := range b {
@@ -146,10 +164,15 @@ func Digits(n int) string {
 }
 
 // Letters generates a random string of letters of length n.
+// When SetSecureMode(true) is in effect, letters are chosen via rejection
+// sampling (see SecureLetters) instead of a biased modulo reduction.
 func Letters(n int) string {
 	if n <= 0 {
 		return ""
 	}
+	if secureMode.Load() {
+		return SecureLetters(n)
+	}
 	b := make([]byte, n)
 	numberBytes := B(n)
 	for i := range b {
@@ -159,10 +182,15 @@ func Letters(n int) string {
 }
 
 // Symbols generates a random string of symbols of length n.
+// When SetSecureMode(true) is in effect, symbols are chosen via rejection
+// sampling (see SecureSymbols) instead of a biased modulo reduction.
 func Symbols(n int) string {
 	if n <= 0 {
 		return ""
 	}
+	if secureMode.Load() {
+		return SecureSymbols(n)
+	}
 	b := make([]byte, n)
 	numberBytes := B(n)
 	for i := range b {
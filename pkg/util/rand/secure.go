@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Focela Technologies. All rights reserved.
+// Internal use only. Unauthorized use is prohibited.
+// Contact: legal@focela.com
+
+// Package random provides high-performance random bytes/number/string generation functionality.
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync/atomic"
+)
+
+// secureMode controls whether the package-level helpers (Intn, B, S, ...)
+// route through crypto/rand instead of the fast bufferChan PRNG. It is off
+// by default so existing callers see no performance regression; enable it
+// with SetSecureMode when those helpers are used for tokens or secrets.
+//
+// Only the Secure* functions in this file (SecureB, SecureS, SecureDigits,
+// SecureLetters, SecureSymbols, SecureIntn) are safe for cryptographic use
+// unconditionally; the plain helpers are safe for cryptographic use only
+// once SetSecureMode(true) has been called.
+var secureMode atomic.Bool
+
+// SetSecureMode enables or disables routing the package-level helpers
+// through crypto/rand.
+func SetSecureMode(enabled bool) {
+	secureMode.Store(enabled)
+}
+
+// SecureB generates n cryptographically secure random bytes via crypto/rand.
+func SecureB(n int) []byte {
+	if n <= 0 {
+		return nil
+	}
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// SecureIntn returns a cryptographically secure random integer in [0, max),
+// using rejection sampling to avoid modulo bias.
+func SecureIntn(max int) int {
+	if max <= 0 {
+		return max
+	}
+	return int(secureUint32Below(uint32(max)))
+}
+
+// SecureS generates a cryptographically secure random string of length n,
+// optionally including symbols.
+func SecureS(n int, useSymbols ...bool) string {
+	if n <= 0 {
+		return ""
+	}
+	set := characters[:62]
+	if len(useSymbols) > 0 && useSymbols[0] {
+		set = characters
+	}
+	return secureStringFromSet(set, n)
+}
+
+// SecureDigits generates a cryptographically secure random string of digits of length n.
+func SecureDigits(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return secureStringFromSet(digits, n)
+}
+
+// SecureLetters generates a cryptographically secure random string of letters of length n.
+func SecureLetters(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return secureStringFromSet(letters, n)
+}
+
+// SecureSymbols generates a cryptographically secure random string of symbols of length n.
+func SecureSymbols(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return secureStringFromSet(symbols, n)
+}
+
+// secureStringFromSet builds an n-byte string by rejection-sampling an index
+// into set for each position, so every character is equally likely
+// regardless of len(set).
+func secureStringFromSet(set string, n int) string {
+	b := make([]byte, n)
+	k := byte(len(set))
+	for i := range b {
+		b[i] = set[secureByteBelow(k)]
+	}
+	return string(b)
+}
+
+// secureByteBelow returns a cryptographically secure random byte in [0, k)
+// with no modulo bias, by rejecting draws from the partial final bucket of
+// floor(256/k)*k and retrying.
+func secureByteBelow(k byte) byte {
+	if k == 0 {
+		return 0
+	}
+	limit := byte((256 / int(k)) * int(k))
+	for {
+		var buf [1]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			panic(err)
+		}
+		if limit == 0 || buf[0] < limit {
+			return buf[0] % k
+		}
+	}
+}
+
+// secureUint32Below returns a cryptographically secure random uint32 in
+// [0, k) with no modulo bias, by rejecting draws from the partial final
+// bucket of floor(2^32/k)*k and retrying.
+func secureUint32Below(k uint32) uint32 {
+	if k == 0 {
+		return 0
+	}
+	limit := (^uint32(0) / k) * k
+	for {
+		var buf [4]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			panic(err)
+		}
+		v := binary.LittleEndian.Uint32(buf[:])
+		if v < limit {
+			return v % k
+		}
+	}
+}